@@ -0,0 +1,77 @@
+package lde
+
+import (
+	"context"
+	"github.com/nathanhack/lde/internal"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+)
+
+//Algorithm computes the Hilbert basis of A𝑥 = 0, the minimal natural solutions other than zero. The
+// solver passed in carries the options, an Algorithm may use its Limits to prune the search and its
+// Workers and Observer as they fit. The bases may be returned in any order. If ctx is done before the
+// bases are known those found so far are returned with an *IncompleteError.
+type Algorithm interface {
+	Hilbert(ctx context.Context, A *mat.Mat, s *Solver) ([]*vec.Vec, error)
+}
+
+//ContejeanDevie grows the unit vectors one step at a time towards the solutions as laid out in the paper
+// in info/. A Solver with no Algorithm uses it unless A has a single row, there is no Checkpoint and
+// Workers is below 2, when it uses ClausenFortenbacher instead.
+type ContejeanDevie struct{}
+
+//Hilbert computes the Hilbert basis of A𝑥 = 0, see Algorithm
+func (ContejeanDevie) Hilbert(ctx context.Context, A *mat.Mat, s *Solver) ([]*vec.Vec, error) {
+	𝓟, eigens := homogeneousStart(A)
+	return s.homogeneous(ctx, A, frontier{level: 1, 𝓟: 𝓟}, eigens, nil, nil)
+}
+
+//contejeanDevie is true when the solver runs the ContejeanDevie search, which solves A𝑥 = b directly
+// and supports streaming and checkpoints
+func (s *Solver) contejeanDevie() bool {
+	switch s.Algorithm.(type) {
+	case nil, ContejeanDevie, *ContejeanDevie:
+		return true
+	}
+	return false
+}
+
+//hilbert runs the solver's Algorithm on A𝑥 = 0 and keeps the bases no limit stops
+func (s *Solver) hilbert(ctx context.Context, A *mat.Mat) ([]*vec.Vec, error) {
+	if s.Checkpoint != nil {
+		return make([]*vec.Vec, 0), &InputError{Arg: "Checkpoint", Detail: "only the ContejeanDevie algorithm can be checkpointed"}
+	}
+	𝓑, err := s.Algorithm.Hilbert(ctx, A, s)
+
+	kept := make([]*vec.Vec, 0, len(𝓑))
+	for _, v := range 𝓑 {
+		if !s.stopped(v) {
+			kept = append(kept, v)
+		}
+	}
+	return kept, err
+}
+
+//nonHomogeneousHilbert solves A𝑥 = b with the solver's Algorithm. The bases of [-b|A]𝑥 = 0 with 𝑥0 = 0
+// are M0 and, unless b is zero, those with 𝑥0 = 1 are M1. Anything with 𝑥0 > 1 is a sum of those so it
+// is limited away. The solver's own limits must already leave 𝑥0 out, see variables.
+func (s *Solver) nonHomogeneousHilbert(ctx context.Context, A *mat.Mat, b *vec.Vec) (M1 []*vec.Vec, M0 []*vec.Vec, err error) {
+	newA, _, _ := nonHomogeneousStart(A, b)
+	augmented := *s
+	augmented.Limits = append(append([]LimitBy{}, s.Limits...), &upperLimit{hi: map[uint]*big.Int{0: internal.One}})
+	𝓑, err := augmented.hilbert(ctx, newA)
+
+	if b.Equals(vec.Zeros(b.Len())) {
+		//as in the search for A𝑥 = b the specific solution of a homogeneous system is left out
+		homogeneous := make([]*vec.Vec, 0, len(𝓑))
+		for _, v := range 𝓑 {
+			if v.Get(0).Sign() == 0 {
+				homogeneous = append(homogeneous, v)
+			}
+		}
+		𝓑 = homogeneous
+	}
+	M1, M0 = split(𝓑)
+	return M1, M0, err
+}
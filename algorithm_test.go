@@ -0,0 +1,119 @@
+package lde
+
+import (
+	"context"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+	"strconv"
+	"testing"
+)
+
+//bruteForce is an Algorithm that checks every vector with values up to max, it only finds the whole
+// Hilbert basis when max is at least its largest value
+type bruteForce struct {
+	max int64
+}
+
+func (b bruteForce) Hilbert(ctx context.Context, A *mat.Mat, s *Solver) ([]*vec.Vec, error) {
+	_, cols := A.Shape()
+	solutions := make([]*vec.Vec, 0)
+	var walk func(v *vec.Vec, i uint)
+	walk = func(v *vec.Vec, i uint) {
+		if i == cols {
+			if !v.Equals(vec.Zeros(cols)) && a(A, v).Equals(vec.Zeros(a(A, v).Len())) {
+				solutions = append(solutions, v)
+			}
+			return
+		}
+		for x := int64(0); x <= b.max; x++ {
+			walk(v.Set(i, big.NewInt(x)), i+1)
+		}
+	}
+	walk(vec.Zeros(cols), 0)
+	return reduce(solutions, func(x, y *vec.Vec) bool { return containedInMinimalSet(x, []*vec.Vec{y}) }), nil
+}
+
+func TestSolver_Algorithm(t *testing.T) {
+	tests := []struct {
+		a      *mat.Mat
+		b      *vec.Vec
+		limits []LimitBy
+	}{
+		{mat.NewMatRows(vec.NewVecInt64(1, 2, -3)), vec.NewVecInt64(0), nil},
+		{mat.NewMatRows(vec.NewVecInt64(1, 1, -2, -1)), vec.NewVecInt64(3), nil},
+		{mat.NewMatRows(vec.NewVecInt64(-1, 1, 2, -3), vec.NewVecInt64(-1, 3, -2, -1)), vec.NewVecInt64(0, 2), nil},
+		{mat.NewMatRows(vec.NewVecInt64(2, -3, 1)), vec.NewVecInt64(1), []LimitBy{NewMaxNormLimit(big.NewInt(3))}},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			ctx := context.Background()
+			expected := &Solver{Limits: test.limits}
+			actual := &Solver{Limits: test.limits, Algorithm: bruteForce{max: 4}}
+
+			eH, err := expected.Homogeneous(ctx, test.a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			aH, err := actual.Homogeneous(ctx, test.a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equalVecs(eH, aH) {
+				t.Errorf("expected %v but found %v", eH, aH)
+			}
+
+			e1, e0, err := expected.NonHomogeneous(ctx, test.a, test.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			a1, a0, err := actual.NonHomogeneous(ctx, test.a, test.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equalVecs(e1, a1) || !equalVecs(e0, a0) {
+				t.Errorf("expected %v %v but found %v %v", e1, e0, a1, a0)
+			}
+		})
+	}
+}
+
+//sameAsContejeanDevie checks alg gives the same results as the default algorithm on a range of systems
+func sameAsContejeanDevie(t *testing.T, alg Algorithm) {
+	tests := []struct {
+		a *mat.Mat
+		b *vec.Vec
+	}{
+		{mat.NewMatRows(vec.NewVecInt64(-1, 1, 2, -3), vec.NewVecInt64(-1, 3, -2, -1)), vec.NewVecInt64(0, 2)},
+		{mat.NewMatRows(vec.NewVecInt64(6, -9, 2)), vec.NewVecInt64(5)},
+		{mat.NewMatRows(vec.NewVecInt64(1, 1, -2, -1)), vec.NewVecInt64(3)},
+		{mat.NewMatRows(vec.NewVecInt64(3, 5, -7, -2, 1)), vec.NewVecInt64(-4)},
+		{mat.NewMatRows(vec.NewVecInt64(1, -1, 0, 2), vec.NewVecInt64(0, 1, -1, -1)), vec.NewVecInt64(1, 0)},
+		{mat.NewMatRows(vec.NewVecInt64(1, 2, 3)), vec.NewVecInt64(4)},
+		{mat.NewMatRows(vec.NewVecInt64(2, 0, -3)), vec.NewVecInt64(0)},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			ctx := context.Background()
+			s := &Solver{Algorithm: alg}
+
+			actual, err := s.Homogeneous(ctx, test.a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected, _ := (&Solver{Algorithm: ContejeanDevie{}}).Homogeneous(ctx, test.a)
+			if !equalVecs(expected, actual) {
+				t.Errorf("expected %v but found %v", expected, actual)
+			}
+
+			a1, a0, err := s.NonHomogeneous(ctx, test.a, test.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			e1, e0, _ := (&Solver{Algorithm: ContejeanDevie{}}).NonHomogeneous(ctx, test.a, test.b)
+			if !equalVecs(e1, a1) || !equalVecs(e0, a0) {
+				t.Errorf("expected %v %v but found %v %v", e1, e0, a1, a0)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package lde
+
+import (
+	"context"
+	"fmt"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+)
+
+//Bound is the inclusive range Lo ≤ 𝑥 ≤ Hi of a single variable. A nil Lo is treated as
+// zero and a nil Hi leaves the variable unbounded above.
+type Bound struct {
+	Lo, Hi *big.Int
+}
+
+//Bounds maps a variable (column) index to its Bound. Variables without an entry are
+// only restricted to the naturals.
+type Bounds map[uint]Bound
+
+//upperLimit stops any vector with a value above its variable's upper bound
+type upperLimit struct {
+	hi map[uint]*big.Int
+}
+
+func (u *upperLimit) Stop(current *vec.Vec) bool {
+	for i, h := range u.hi {
+		if current.Get(i).Cmp(h) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+//NonHomogeneousBounded solves A𝑥 = b with every variable kept inside its Bound. Lower bounds are
+// handled by shifting b and upper bounds prune the search. The results are in the original
+// coordinates: every bounded solution is one from M1 plus bases from M0, and all of M1 and M0
+// lie inside the bounds.
+func NonHomogeneousBounded(A *mat.Mat, b *vec.Vec, bounds Bounds, limits ...LimitBy) (M1 []*vec.Vec, M0 []*vec.Vec) {
+	M1, M0, err := (&Solver{Limits: limits}).NonHomogeneousBounded(context.Background(), A, b, bounds)
+	mustBeValid(err)
+	return M1, M0
+}
+
+//NonHomogeneousBounded solves A𝑥 = b with every variable kept inside its Bound, see the
+// package level NonHomogeneousBounded.
+func (s *Solver) NonHomogeneousBounded(ctx context.Context, A *mat.Mat, b *vec.Vec, bounds Bounds) (M1 []*vec.Vec, M0 []*vec.Vec, err error) {
+	if err := validateB(A, b); err != nil {
+		return make([]*vec.Vec, 0), make([]*vec.Vec, 0), err
+	}
+	_, cols := A.Shape()
+	if err := validateBounds(bounds, cols); err != nil {
+		return make([]*vec.Vec, 0), make([]*vec.Vec, 0), err
+	}
+
+	lo := vec.Zeros(cols)
+	hi := make(map[uint]*big.Int)
+	for i, bound := range bounds {
+		if bound.Lo != nil {
+			lo = lo.Set(i, bound.Lo)
+		}
+		if bound.Hi != nil {
+			h := new(big.Int).Sub(bound.Hi, lo.Get(i))
+			if h.Sign() < 0 {
+				//the range is empty so there is nothing to find
+				return make([]*vec.Vec, 0), make([]*vec.Vec, 0), nil
+			}
+			hi[i] = h
+		}
+	}
+
+	//with 𝑥 = lo + 𝑦 we solve A𝑦 = b - A⋅lo for 𝑦 ≥ 0
+	shifted := b.Sub(a(A, lo))
+	bounded := *s
+	bounded.Checkpoint = nil
+	bounded.Limits = append(append([]LimitBy{}, s.Limits...), &upperLimit{hi: hi})
+	M1, M0, err = bounded.NonHomogeneous(ctx, A, shifted)
+
+	if shifted.Equals(vec.Zeros(shifted.Len())) {
+		//the lower bounds are the only specific solution
+		if !lo.Equals(vec.Zeros(cols)) {
+			M1 = append(M1, vec.Zeros(cols))
+		}
+	}
+	for i, y := range M1 {
+		M1[i] = y.Add(lo)
+	}
+	return s.order(M1), M0, err
+}
+
+//validateBounds checks every bound is for one of the cols variables and has no negative lower bound
+func validateBounds(bounds Bounds, cols uint) error {
+	for i, bound := range bounds {
+		if i >= cols {
+			return &InputError{Arg: "bounds", Detail: fmt.Sprintf("bound for variable %v but there are only %v variables", i, cols)}
+		}
+		if bound.Lo != nil && bound.Lo.Sign() < 0 {
+			return &InputError{Arg: "bounds", Detail: fmt.Sprintf("lower bound for variable %v must not be negative, found %v", i, bound.Lo)}
+		}
+	}
+	return nil
+}
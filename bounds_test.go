@@ -0,0 +1,73 @@
+package lde
+
+import (
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+	"strconv"
+	"testing"
+)
+
+func TestNonHomogeneousBounded(t *testing.T) {
+	tests := []struct {
+		a          *mat.Mat
+		b          *vec.Vec
+		bounds     Bounds
+		expectedM1 []*vec.Vec
+		expectedM0 []*vec.Vec
+	}{
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, 1)),
+			vec.NewVecInt64(5),
+			Bounds{0: {Hi: big.NewInt(100)}, 1: {Lo: big.NewInt(2), Hi: big.NewInt(4)}},
+			[]*vec.Vec{vec.NewVecInt64(1, 4), vec.NewVecInt64(2, 3), vec.NewVecInt64(3, 2)},
+			[]*vec.Vec{},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(6, -9, 2)),
+			vec.NewVecInt64(0),
+			Bounds{2: {Hi: big.NewInt(6)}},
+			[]*vec.Vec{},
+			[]*vec.Vec{vec.NewVecInt64(1, 2, 6), vec.NewVecInt64(2, 2, 3), vec.NewVecInt64(3, 2, 0)},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, -1)),
+			vec.NewVecInt64(0),
+			Bounds{0: {Lo: big.NewInt(3)}},
+			[]*vec.Vec{vec.NewVecInt64(3, 3)},
+			[]*vec.Vec{vec.NewVecInt64(1, 1)},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, 1)),
+			vec.NewVecInt64(5),
+			Bounds{1: {Lo: big.NewInt(4), Hi: big.NewInt(3)}},
+			[]*vec.Vec{},
+			[]*vec.Vec{},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, 1), vec.NewVecInt64(1, 2)),
+			vec.NewVecInt64(1, 1),
+			Bounds{0: {Hi: big.NewInt(0)}},
+			[]*vec.Vec{},
+			[]*vec.Vec{},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, 1, 2)),
+			vec.NewVecInt64(2),
+			Bounds{1: {Hi: big.NewInt(0)}},
+			[]*vec.Vec{vec.NewVecInt64(0, 0, 1), vec.NewVecInt64(2, 0, 0)},
+			[]*vec.Vec{},
+		},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			actualM1, actualM0 := NonHomogeneousBounded(test.a, test.b, test.bounds)
+			if !equalVecs(test.expectedM1, actualM1) {
+				t.Errorf("expected %v but found %v", test.expectedM1, actualM1)
+			}
+			if !equalVecs(test.expectedM0, actualM0) {
+				t.Errorf("expected %v but found %v", test.expectedM0, actualM0)
+			}
+		})
+	}
+}
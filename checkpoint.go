@@ -0,0 +1,245 @@
+package lde
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"io"
+	"math/big"
+)
+
+//frontier is where a search picks up from, the vectors in 𝓟 at the start of level with the bases in 𝓑
+// already found
+type frontier struct {
+	level int
+	𝓟     []*fvec
+	𝓑     []*vec.Vec
+}
+
+//Snapshot is the state of a Homogeneous or NonHomogeneous search at the start of a level. It holds the
+// system, the limits, the frontier and the bases found so far, which is all Resume needs to finish the
+// search with the same result.
+type Snapshot struct {
+	//matrix and b are the system as given, b is nil for Homogeneous
+	matrix *mat.Mat
+	b      *vec.Vec
+	//limits are the encoded Limits of the solver
+	limits []limitJSON
+	frontier
+}
+
+//Level is the level the search will carry on from
+func (s *Snapshot) Level() int {
+	return s.level
+}
+
+//at returns a copy of the snapshot holding the frontier f
+func (s *Snapshot) at(f frontier) *Snapshot {
+	t := *s
+	t.frontier = frontier{
+		level: f.level,
+		𝓟:     append([]*fvec{}, f.𝓟...),
+		𝓑:     append([]*vec.Vec{}, f.𝓑...),
+	}
+	return &t
+}
+
+type snapshotJSON struct {
+	Cols     uint            `json:"cols"`
+	A        [][]*big.Int    `json:"a"`
+	B        []*big.Int      `json:"b,omitempty"`
+	Limits   []limitJSON     `json:"limits,omitempty"`
+	Level    int             `json:"level"`
+	Frontier [][2][]*big.Int `json:"frontier"`
+	Bases    [][]*big.Int    `json:"bases"`
+}
+
+type limitJSON struct {
+	Kind  string            `json:"kind"`
+	Value *big.Int          `json:"value,omitempty"`
+	Hi    map[uint]*big.Int `json:"hi,omitempty"`
+}
+
+//WriteTo writes the snapshot to w as JSON, read it back with ReadSnapshot
+func (s *Snapshot) WriteTo(w io.Writer) (int64, error) {
+	_, cols := s.matrix.Shape()
+	t := snapshotJSON{
+		Cols:     cols,
+		A:        make([][]*big.Int, 0),
+		Limits:   s.limits,
+		Level:    s.level,
+		Frontier: make([][2][]*big.Int, len(s.𝓟)),
+		Bases:    make([][]*big.Int, len(s.𝓑)),
+	}
+	for _, row := range s.matrix.GetRows() {
+		t.A = append(t.A, values(row.Slice(0, cols)))
+	}
+	if s.b != nil {
+		t.B = values(s.b)
+	}
+	for i, 𝑥 := range s.𝓟 {
+		t.Frontier[i] = [2][]*big.Int{values(𝑥.v), values(𝑥.f)}
+	}
+	for i, v := range s.𝓑 {
+		t.Bases[i] = values(v)
+	}
+
+	c := &countingWriter{w: w}
+	err := json.NewEncoder(c).Encode(t)
+	return c.n, err
+}
+
+//ReadSnapshot reads a snapshot written by Snapshot.WriteTo
+func ReadSnapshot(r io.Reader) (*Snapshot, error) {
+	t := snapshotJSON{}
+	if err := json.NewDecoder(r).Decode(&t); err != nil {
+		return nil, err
+	}
+
+	flat := make([]*big.Int, 0, uint(len(t.A))*t.Cols)
+	for i, row := range t.A {
+		if uint(len(row)) != t.Cols {
+			return nil, &InputError{Arg: "snapshot", Detail: fmt.Sprintf("row %v of A does not have %v values", i, t.Cols)}
+		}
+		flat = append(flat, row...)
+	}
+	A, err := mat.NewMatChecked(uint(len(t.A)), t.Cols, flat...)
+	if err != nil {
+		return nil, err
+	}
+	s := &Snapshot{matrix: A, limits: t.Limits}
+	if t.B != nil {
+		s.b = vec.NewVec(t.B...)
+		if err := validateB(A, s.b); err != nil {
+			return nil, err
+		}
+	} else if err := validate(A); err != nil {
+		return nil, err
+	}
+	for _, l := range t.Limits {
+		if _, err := decodeLimit(l); err != nil {
+			return nil, err
+		}
+	}
+
+	//the search runs over the columns of A with -b in front for NonHomogeneous
+	_, n := A.Shape()
+	if s.b != nil {
+		n++
+	}
+	s.level = t.Level
+	s.𝓟 = make([]*fvec, len(t.Frontier))
+	for i, 𝑥 := range t.Frontier {
+		s.𝓟[i] = &fvec{v: vec.NewVec(𝑥[0]...), f: vec.NewVec(𝑥[1]...)}
+		if s.𝓟[i].v.Len() != n || s.𝓟[i].f.Len() != n {
+			return nil, &InputError{Arg: "snapshot", Detail: fmt.Sprintf("frontier vector %v does not have %v values", i, n)}
+		}
+	}
+	s.𝓑 = make([]*vec.Vec, len(t.Bases))
+	for i, v := range t.Bases {
+		s.𝓑[i] = vec.NewVec(v...)
+		if s.𝓑[i].Len() != n {
+			return nil, &InputError{Arg: "snapshot", Detail: fmt.Sprintf("basis %v does not have %v values", i, n)}
+		}
+	}
+	return s, nil
+}
+
+//Resume carries on the search saved in snapshot with the limits it was taken with, and returns the
+// same results as the solve it came from. For NonHomogeneous those are M1 and M0, for Homogeneous M1
+// is empty and M0 holds the bases. The solver's Workers, Observer, Order and Checkpoint are used as
+// usual but its Limits are ignored.
+func (s *Solver) Resume(ctx context.Context, snapshot *Snapshot) (M1 []*vec.Vec, M0 []*vec.Vec, err error) {
+	resumed := *s
+	resumed.Limits = make([]LimitBy, len(snapshot.limits))
+	for i, l := range snapshot.limits {
+		if resumed.Limits[i], err = decodeLimit(l); err != nil {
+			return make([]*vec.Vec, 0), make([]*vec.Vec, 0), err
+		}
+	}
+
+	next, err := resumed.snapshot(snapshot.matrix, snapshot.b)
+	if err != nil {
+		return make([]*vec.Vec, 0), make([]*vec.Vec, 0), err
+	}
+
+	if snapshot.b == nil {
+		_, eigens := homogeneousStart(snapshot.matrix)
+		𝓑, err := resumed.homogeneous(ctx, snapshot.matrix, snapshot.frontier, eigens, next, nil)
+		return make([]*vec.Vec, 0), resumed.order(𝓑), err
+	}
+	_, cols := snapshot.matrix.Shape()
+	newA, _, eigens := nonHomogeneousStart(snapshot.matrix, snapshot.b)
+	𝓑, err := resumed.variables(cols).homogeneous(ctx, newA, snapshot.frontier, eigens, next, nil)
+	M1, M0 = split(𝓑)
+	return resumed.order(M1), resumed.order(M0), err
+}
+
+//snapshot returns the snapshot to fill in at each level of solving A𝑥 = b, or nil when the solver has no
+// Checkpoint. It returns an *InputError when one of the limits can not be saved.
+func (s *Solver) snapshot(A *mat.Mat, b *vec.Vec) (*Snapshot, error) {
+	if s.Checkpoint == nil {
+		return nil, nil
+	}
+	snapshot := &Snapshot{matrix: A, b: b, limits: make([]limitJSON, len(s.Limits))}
+	for i, l := range s.Limits {
+		var err error
+		if snapshot.limits[i], err = encodeLimit(l); err != nil {
+			return nil, err
+		}
+	}
+	return snapshot, nil
+}
+
+//encodeLimit saves one of the built in limits, any other returns an *InputError
+func encodeLimit(l LimitBy) (limitJSON, error) {
+	switch l := l.(type) {
+	case *maxX:
+		return limitJSON{Kind: "maxX", Value: l.v}, nil
+	case *maxNorm:
+		return limitJSON{Kind: "maxNorm", Value: l.v}, nil
+	case *upperLimit:
+		return limitJSON{Kind: "bounds", Hi: l.hi}, nil
+	}
+	return limitJSON{}, &InputError{Arg: "Limits", Detail: fmt.Sprintf("%T can not be saved in a snapshot", l)}
+}
+
+//decodeLimit restores a limit saved by encodeLimit
+func decodeLimit(l limitJSON) (LimitBy, error) {
+	switch l.Kind {
+	case "maxX":
+		if l.Value != nil {
+			return NewMaxXLimit(l.Value), nil
+		}
+	case "maxNorm":
+		if l.Value != nil {
+			return NewMaxNormLimit(l.Value), nil
+		}
+	case "bounds":
+		return &upperLimit{hi: l.Hi}, nil
+	}
+	return nil, &InputError{Arg: "snapshot", Detail: fmt.Sprintf("unknown limit %q", l.Kind)}
+}
+
+//values returns the values of v
+func values(v *vec.Vec) []*big.Int {
+	t := make([]*big.Int, v.Len())
+	for i := range t {
+		t[i] = v.Get(uint(i))
+	}
+	return t
+}
+
+//countingWriter counts the bytes written through it for WriteTo
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
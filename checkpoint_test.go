@@ -0,0 +1,113 @@
+package lde
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestSolver_Resume(t *testing.T) {
+	tests := []struct {
+		a      *mat.Mat
+		b      *vec.Vec
+		limits []LimitBy
+	}{
+		{mat.NewMatRows(vec.NewVecInt64(1, 2, -3)), nil, nil},
+		{mat.NewMatRows(vec.NewVecInt64(-1, 1, 2, -3), vec.NewVecInt64(-1, 3, -2, -1)), nil, nil},
+		{mat.NewMatRows(vec.NewVecInt64(1, 1, -2, -1)), vec.NewVecInt64(3), nil},
+		{mat.NewMatRows(vec.NewVecInt64(2, -3, 1)), vec.NewVecInt64(1), []LimitBy{NewMaxXLimit(big.NewInt(4))}},
+		{mat.NewMatRows(vec.NewVecInt64(2, -3, 1)), vec.NewVecInt64(1), []LimitBy{NewMaxNormLimit(big.NewInt(3)), NewBoundsLimit(Bounds{2: {Hi: big.NewInt(1)}})}},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			ctx := context.Background()
+			snapshots := make([][]byte, 0)
+			s := &Solver{Limits: test.limits, Checkpoint: func(snapshot *Snapshot) error {
+				buf := bytes.Buffer{}
+				if _, err := snapshot.WriteTo(&buf); err != nil {
+					return err
+				}
+				snapshots = append(snapshots, buf.Bytes())
+				return nil
+			}}
+
+			var M1, M0 []*vec.Vec
+			var err error
+			if test.b == nil {
+				M1 = make([]*vec.Vec, 0)
+				M0, err = s.Homogeneous(ctx, test.a)
+			} else {
+				M1, M0, err = s.NonHomogeneous(ctx, test.a, test.b)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(snapshots) == 0 {
+				t.Fatal("expected snapshots to be taken")
+			}
+
+			for level, data := range snapshots {
+				snapshot, err := ReadSnapshot(bytes.NewReader(data))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if snapshot.Level() != level+1 {
+					t.Errorf("expected level %v but found %v", level+1, snapshot.Level())
+				}
+				R1, R0, err := (&Solver{}).Resume(ctx, snapshot)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !equalVecs(M1, R1) || !equalVecs(M0, R0) {
+					t.Errorf("resuming at level %v expected %v %v but found %v %v", level+1, M1, M0, R1, R0)
+				}
+			}
+		})
+	}
+}
+
+func TestSolver_CheckpointErrors(t *testing.T) {
+	ctx := context.Background()
+	A := mat.NewMatRows(vec.NewVecInt64(1, 2, -3))
+
+	s := &Solver{Limits: []LimitBy{&sliceLimit{}}, Checkpoint: func(*Snapshot) error { return nil }}
+	var input *InputError
+	if _, err := s.Homogeneous(ctx, A); !errors.As(err, &input) {
+		t.Errorf("expected an *InputError for a limit that can not be saved but found %v", err)
+	}
+
+	stop := errors.New("stop")
+	s = &Solver{Checkpoint: func(snapshot *Snapshot) error {
+		if snapshot.Level() == 3 {
+			return stop
+		}
+		return nil
+	}}
+	var incomplete *IncompleteError
+	if _, err := s.Homogeneous(ctx, A); !errors.As(err, &incomplete) || !errors.Is(err, stop) {
+		t.Errorf("expected an *IncompleteError wrapping %v but found %v", stop, err)
+	}
+}
+
+func TestReadSnapshot_Malformed(t *testing.T) {
+	tests := []string{
+		`{"cols":2,"a":[[1,2,3],[1]],"level":1,"frontier":[],"bases":[]}`,
+		`{"cols":2,"a":[[1,-1]],"b":[1],"level":1,"frontier":[[[1,0],[0,0]]],"bases":[]}`,
+		`{"cols":2,"a":[[1,-1]],"b":[1],"level":1,"frontier":[],"bases":[[1,1]]}`,
+		`{"cols":2,"a":[[1,-1]],"limits":[{"kind":"other"}],"level":1,"frontier":[],"bases":[]}`,
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			var input *InputError
+			if _, err := ReadSnapshot(strings.NewReader(test)); !errors.As(err, &input) {
+				t.Errorf("expected an *InputError but found %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package lde
+
+import (
+	"context"
+	"fmt"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+	"time"
+)
+
+//ClausenFortenbacher is an Algorithm for a single equation a⋅𝑥 = 0. It is not the graph algorithm of
+// Clausen and Fortenbacher but a level search like ContejeanDevie that borrows its idea of nodes, the
+// values a⋅𝑥 can take on the way to a solution, to drop a vector as soon as a smaller one has visited
+// the same node. A Solver with no Algorithm uses it whenever A has one row, there is no Checkpoint and
+// Workers is below 2, as the search does not spread over workers. Any other A returns an *InputError.
+type ClausenFortenbacher struct{}
+
+//Hilbert computes the Hilbert basis of A𝑥 = 0, see Algorithm
+func (ClausenFortenbacher) Hilbert(ctx context.Context, A *mat.Mat, s *Solver) ([]*vec.Vec, error) {
+	rows, _ := A.Shape()
+	if rows != 1 {
+		return make([]*vec.Vec, 0), &InputError{Arg: "A", Detail: fmt.Sprintf("ClausenFortenbacher needs a single equation but found %v", rows)}
+	}
+	_, eigens := homogeneousStart(A)
+	return s.clausenFortenbacher(ctx, A.GetRow(0), eigens, 0, nil)
+}
+
+//singleEquation is true when the solver should use ClausenFortenbacher on its own for A
+func (s *Solver) singleEquation(A *mat.Mat) bool {
+	rows, _ := A.Shape()
+	return rows == 1 && s.Algorithm == nil && s.Checkpoint == nil && s.Workers < 2
+}
+
+//clausenFortenbacherStart returns the equation and starting vectors to solve a⋅𝑥 = b with, the
+// first variable of the equation is 𝑥0 standing for -b and it is only ever set by a start
+func clausenFortenbacherStart(a *vec.Vec, b *vec.Vec) (*vec.Vec, []*vec.Vec) {
+	n := a.Len() + 1
+	starts := make([]*vec.Vec, 0, n)
+	if b.Get(0).Sign() != 0 {
+		starts = append(starts, vec.Eigen(0).Slice(0, n))
+	}
+	for i := uint(1); i < n; i++ {
+		starts = append(starts, vec.Eigen(i).Slice(0, n))
+	}
+	return lift(vec.NewVec(new(big.Int).Neg(b.Get(0))), a), starts
+}
+
+//clausenFortenbacher finds the minimal solutions of a⋅𝑥 = 0 reachable from the starts. It works a level
+// at a time like homogeneous, growing 𝑥 by e𝑖 when a⋅𝑥 and a𝑖 have opposite signs, but only for 𝑖 ≥ from.
+// A vector is dropped when it contains a solution or an earlier vector with the same a⋅𝑥, as taking
+// that vector away from it leaves a solution. emit works as in homogeneous.
+func (s *Solver) clausenFortenbacher(ctx context.Context, a *vec.Vec, starts []*vec.Vec, from uint, emit func(*vec.Vec) bool) ([]*vec.Vec, error) {
+	𝓑 := make([]*vec.Vec, 0)
+	//visited holds every vector seen so far by the node it reached
+	visited := make(map[string][]*vec.Vec)
+
+	//the starts are checked against the limits here as nothing else grows them
+	level := make([]*vec.Vec, 0, len(starts))
+	seen := make(map[string]bool)
+	startPruned := 0
+	for _, x := range starts {
+		if s.stopped(x) {
+			startPruned++
+			continue
+		}
+		if !seen[x.String()] {
+			seen[x.String()] = true
+			level = append(level, x)
+		}
+	}
+
+	for depth := 1; len(level) > 0; depth++ {
+		if err := ctx.Err(); err != nil {
+			return 𝓑, &IncompleteError{Err: err}
+		}
+		stats := LevelStats{Level: depth, Frontier: len(level), Pruned: startPruned}
+		startPruned = 0
+		start := time.Now()
+
+		//the solutions come first so nothing grown this level can contain one of them
+		defects := make([]*big.Int, len(level))
+		growing := make([]int, 0, len(level))
+		for k, x := range level {
+			defects[k] = a.Dot(x)
+			if defects[k].Sign() != 0 {
+				growing = append(growing, k)
+				continue
+			}
+			𝓑 = append(𝓑, x)
+			stats.Solutions++
+			if emit != nil && !emit(x) {
+				s.observe(stats, start)
+				return 𝓑, nil
+			}
+		}
+
+		next := make([]*vec.Vec, 0)
+		seen = make(map[string]bool)
+		for _, k := range growing {
+			x, d := level[k], defects[k]
+			for i := from; i < a.Len(); i++ {
+				if d.Sign()*a.Get(i).Sign() >= 0 {
+					continue
+				}
+				y := x.Add(vec.Eigen(i).Slice(0, x.Len()))
+				key := y.String()
+				if seen[key] {
+					continue
+				}
+				if s.stopped(y) {
+					stats.Pruned++
+					continue
+				}
+				if containedInMinimalSet(y, 𝓑) || containedInMinimalSet(y, visited[new(big.Int).Add(d, a.Get(i)).String()]) {
+					stats.Contained++
+					continue
+				}
+				seen[key] = true
+				next = append(next, y)
+			}
+		}
+		for k, x := range level {
+			node := defects[k].String()
+			visited[node] = append(visited[node], x)
+		}
+		level = next
+		s.observe(stats, start)
+	}
+	return 𝓑, nil
+}
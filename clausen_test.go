@@ -0,0 +1,84 @@
+package lde
+
+import (
+	"context"
+	"errors"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+	"strconv"
+	"testing"
+)
+
+func TestSolver_SingleEquation(t *testing.T) {
+	tests := []struct {
+		a      *vec.Vec
+		b      *vec.Vec
+		limits []LimitBy
+	}{
+		{vec.NewVecInt64(6, -9, 2), vec.NewVecInt64(5), nil},
+		{vec.NewVecInt64(3, 5, -7, -2, 1), vec.NewVecInt64(-4), nil},
+		{vec.NewVecInt64(0, 2, -3), vec.NewVecInt64(1), nil},
+		{vec.NewVecInt64(4, -6, 3, -1), vec.NewVecInt64(0), nil},
+		{vec.NewVecInt64(7, -5, 2), vec.NewVecInt64(3), []LimitBy{NewMaxXLimit(big.NewInt(4))}},
+		{vec.NewVecInt64(1, 1, -2, -1), vec.NewVecInt64(3), []LimitBy{NewMaxNormLimit(big.NewInt(4))}},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			ctx := context.Background()
+			A := mat.NewMatRows(test.a)
+			expected := &Solver{Algorithm: ContejeanDevie{}, Limits: test.limits}
+			actual := &Solver{Limits: test.limits}
+
+			eH, _ := expected.Homogeneous(ctx, A)
+			aH, err := actual.Homogeneous(ctx, A)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equalVecs(eH, aH) {
+				t.Errorf("expected %v but found %v", eH, aH)
+			}
+
+			e1, e0, _ := expected.NonHomogeneous(ctx, A, test.b)
+			a1, a0, err := actual.NonHomogeneous(ctx, A, test.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equalVecs(e1, a1) || !equalVecs(e0, a0) {
+				t.Errorf("expected %v %v but found %v %v", e1, e0, a1, a0)
+			}
+			g1, g0, err := (&Solver{Algorithm: ClausenFortenbacher{}, Limits: test.limits}).NonHomogeneous(ctx, A, test.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equalVecs(e1, g1) || !equalVecs(e0, g0) {
+				t.Errorf("expected %v %v but found %v %v", e1, e0, g1, g0)
+			}
+
+			f1, f0 := make([]*vec.Vec, 0), make([]*vec.Vec, 0)
+			err = actual.NonHomogeneousFunc(ctx, A, test.b, func(x *vec.Vec, specific bool) bool {
+				if specific {
+					f1 = append(f1, x)
+				} else {
+					f0 = append(f0, x)
+				}
+				return true
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equalVecs(e1, distinct(f1)) || !equalVecs(e0, distinct(f0)) {
+				t.Errorf("expected %v %v but found %v %v", e1, e0, f1, f0)
+			}
+		})
+	}
+}
+
+func TestClausenFortenbacher_Rows(t *testing.T) {
+	A := mat.NewMatRows(vec.NewVecInt64(1, -1), vec.NewVecInt64(2, -2))
+	_, err := (&Solver{Algorithm: ClausenFortenbacher{}}).Homogeneous(context.Background(), A)
+	var input *InputError
+	if !errors.As(err, &input) {
+		t.Errorf("expected an *InputError but found %v", err)
+	}
+}
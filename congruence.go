@@ -0,0 +1,77 @@
+package lde
+
+import (
+	"context"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+)
+
+//Congruence solves A𝑥 ≡ b (mod m) over the naturals, where m𝑖 is the modulus of row 𝑖. A zero modulus
+// makes the row an equation. It returns the minimal solutions (M1) and the periodic generators (M0),
+// every solution is one from M1 plus any number from M0. Each row with a non-zero modulus becomes the
+// equation A𝑖𝑥 - m𝑖𝑘𝑖 = b𝑖 with its own free 𝑘𝑖, the 𝑘𝑖 are dropped from the results and the limits
+// only see 𝑥.
+func Congruence(A *mat.Mat, b *vec.Vec, m *vec.Vec, limits ...LimitBy) (M1 []*vec.Vec, M0 []*vec.Vec) {
+	M1, M0, err := (&Solver{Limits: limits}).Congruence(context.Background(), A, b, m)
+	mustBeValid(err)
+	return M1, M0
+}
+
+//Congruence solves A𝑥 ≡ b (mod m) over the naturals, see the package level Congruence.
+func (s *Solver) Congruence(ctx context.Context, A *mat.Mat, b *vec.Vec, m *vec.Vec) (M1 []*vec.Vec, M0 []*vec.Vec, err error) {
+	if err := validateB(A, b); err != nil {
+		return make([]*vec.Vec, 0), make([]*vec.Vec, 0), err
+	}
+	if err := validateLen("m", m, A); err != nil {
+		return make([]*vec.Vec, 0), make([]*vec.Vec, 0), err
+	}
+	rows, cols := A.Shape()
+
+	//each modulus row becomes A𝑖𝑥 - m𝑖𝑘𝑖 = b𝑖 with a free 𝑘𝑖
+	c := A.GetCols()
+	domains := make([]Domain, cols)
+	moduli := make([]*big.Int, rows)
+	for r := uint(0); r < rows; r++ {
+		moduli[r] = new(big.Int).Abs(m.Get(r))
+		if moduli[r].Sign() == 0 {
+			continue
+		}
+		c = append(c, vec.Zeros(rows).Set(r, new(big.Int).Neg(moduli[r])))
+		domains = append(domains, Free)
+	}
+
+	//the limits should only see 𝑥 and not the multiples of the moduli
+	mixed := *s
+	mixed.Limits = make([]LimitBy, len(s.Limits))
+	for i, l := range s.Limits {
+		mixed.Limits[i] = &sliceLimit{limit: l, start: 0, end: cols}
+	}
+
+	M1, M0, err = mixed.Mixed(ctx, mat.NewMatCols(c...), domains, b)
+
+	inCone := func(d *vec.Vec) bool {
+		if !natural(d) {
+			return false
+		}
+		ad := a(A, d)
+		for r, modulus := range moduli {
+			x := ad.Get(uint(r))
+			if modulus.Sign() == 0 && x.Sign() != 0 || modulus.Sign() != 0 && x.Mod(x, modulus).Sign() != 0 {
+				return false
+			}
+		}
+		return true
+	}
+
+	M1 = minimal(project(M1, cols), inCone)
+
+	generators := make([]*vec.Vec, 0)
+	for _, g := range project(M0, cols) {
+		if !g.Equals(vec.Zeros(0)) {
+			generators = append(generators, g)
+		}
+	}
+	M0 = minimal(generators, inCone)
+	return s.order(M1), s.order(M0), err
+}
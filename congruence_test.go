@@ -0,0 +1,57 @@
+package lde
+
+import (
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"strconv"
+	"testing"
+)
+
+func TestCongruence(t *testing.T) {
+	tests := []struct {
+		a          *mat.Mat
+		b, m       *vec.Vec
+		expectedM1 []*vec.Vec
+		expectedM0 []*vec.Vec
+	}{
+		{
+			mat.NewMatRows(vec.NewVecInt64(2)),
+			vec.NewVecInt64(1),
+			vec.NewVecInt64(3),
+			[]*vec.Vec{vec.NewVecInt64(2)},
+			[]*vec.Vec{vec.NewVecInt64(3)},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, 1)),
+			vec.NewVecInt64(0),
+			vec.NewVecInt64(2),
+			[]*vec.Vec{},
+			[]*vec.Vec{vec.NewVecInt64(0, 2), vec.NewVecInt64(1, 1), vec.NewVecInt64(2, 0)},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, 2)),
+			vec.NewVecInt64(1),
+			vec.NewVecInt64(3),
+			[]*vec.Vec{vec.NewVecInt64(0, 2), vec.NewVecInt64(1, 0)},
+			[]*vec.Vec{vec.NewVecInt64(0, 3), vec.NewVecInt64(1, 1), vec.NewVecInt64(3, 0)},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, 1), vec.NewVecInt64(1, 0)),
+			vec.NewVecInt64(3, 1),
+			vec.NewVecInt64(0, 2),
+			[]*vec.Vec{vec.NewVecInt64(1, 2), vec.NewVecInt64(3, 0)},
+			[]*vec.Vec{},
+		},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			actualM1, actualM0 := Congruence(test.a, test.b, test.m)
+			if !equalVecs(test.expectedM1, actualM1) {
+				t.Errorf("expected %v but found %v", test.expectedM1, actualM1)
+			}
+			if !equalVecs(test.expectedM0, actualM0) {
+				t.Errorf("expected %v but found %v", test.expectedM0, actualM0)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package lde
+
+import (
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+)
+
+//Count returns the exact number of natural solutions of A𝑥 = b inside bounds without enumerating them.
+// A variable without a Hi must be bounded by a row whose coefficients all share its sign, otherwise
+// an *UnboundedError is returned. The work grows with the number of distinct partial sums so it
+// suits systems with small coefficients and bounds.
+func Count(A *mat.Mat, b *vec.Vec, bounds Bounds) (*big.Int, error) {
+	if err := validateB(A, b); err != nil {
+		return nil, err
+	}
+	rows, cols := A.Shape()
+	if err := validateBounds(bounds, cols); err != nil {
+		return nil, err
+	}
+
+	//with 𝑥 = lo + 𝑦 we count 𝑦 with A𝑦 = b - A⋅lo and 0 ≤ 𝑦 ≤ hi - lo
+	lo := vec.Zeros(cols)
+	for i, bound := range bounds {
+		if bound.Lo != nil {
+			lo = lo.Set(i, bound.Lo)
+		}
+	}
+	shifted := b.Sub(a(A, lo)).Slice(0, rows)
+
+	hi := make([]*big.Int, cols)
+	for j := uint(0); j < cols; j++ {
+		if bound, has := bounds[j]; has && bound.Hi != nil {
+			hi[j] = new(big.Int).Sub(bound.Hi, lo.Get(j))
+		}
+		if implied := impliedBound(A, shifted, j); implied != nil && (hi[j] == nil || implied.Cmp(hi[j]) < 0) {
+			hi[j] = implied
+		}
+		if hi[j] == nil {
+			return nil, &UnboundedError{Variable: j}
+		}
+		if hi[j].Sign() < 0 {
+			return new(big.Int), nil
+		}
+	}
+
+	//low[𝑗] and high[𝑗] are the smallest and largest each row can still change by using variables 𝑗 on
+	// any partial sum outside of them can never reach zero
+	low := make([]*vec.Vec, cols+1)
+	high := make([]*vec.Vec, cols+1)
+	low[cols], high[cols] = vec.Zeros(rows), vec.Zeros(rows)
+	for j := int(cols) - 1; j >= 0; j-- {
+		most := A.GetCol(uint(j)).Scalar(hi[j])
+		low[j], high[j] = low[j+1], high[j+1]
+		for r := uint(0); r < rows; r++ {
+			if most.Get(r).Sign() < 0 {
+				low[j] = low[j].Set(r, new(big.Int).Add(low[j].Get(r), most.Get(r)))
+			} else {
+				high[j] = high[j].Set(r, new(big.Int).Add(high[j].Get(r), most.Get(r)))
+			}
+		}
+	}
+	reachable := func(residual *vec.Vec, j uint) bool {
+		for r := uint(0); r < rows; r++ {
+			v := residual.Get(r)
+			if v.Cmp(low[j].Get(r)) < 0 || v.Cmp(high[j].Get(r)) > 0 {
+				return false
+			}
+		}
+		return true
+	}
+
+	type state struct {
+		residual *vec.Vec
+		count    *big.Int
+	}
+	states := map[string]*state{shifted.String(): {residual: shifted, count: big.NewInt(1)}}
+	for j := uint(0); j < cols; j++ {
+		column := A.GetCol(j)
+		next := make(map[string]*state)
+		for _, s := range states {
+			residual := s.residual
+			for v := new(big.Int); v.Cmp(hi[j]) <= 0; v.Add(v, big.NewInt(1)) {
+				if reachable(residual, j+1) {
+					key := residual.String()
+					if n, has := next[key]; has {
+						n.count.Add(n.count, s.count)
+					} else {
+						next[key] = &state{residual: residual, count: new(big.Int).Set(s.count)}
+					}
+				}
+				residual = residual.Sub(column)
+			}
+		}
+		states = next
+	}
+
+	if s, has := states[vec.Zeros(rows).String()]; has {
+		return s.count, nil
+	}
+	return new(big.Int), nil
+}
+
+//impliedBound works out an upper bound for variable j from a row whose coefficients all share the
+// sign of its non-zero coefficient for j. It returns nil when there is no such row.
+func impliedBound(A *mat.Mat, b *vec.Vec, j uint) *big.Int {
+	rows, cols := A.Shape()
+	var best *big.Int
+	for r := uint(0); r < rows; r++ {
+		sign := A.Get(r, j).Sign()
+		if sign == 0 {
+			continue
+		}
+		shared := true
+		for c := uint(0); c < cols; c++ {
+			if A.Get(r, c).Sign() == -sign {
+				shared = false
+				break
+			}
+		}
+		if !shared {
+			continue
+		}
+		//every other term pulls the same way so a𝑟𝑗⋅𝑥𝑗 can not pass b𝑟
+		num, den := b.Get(r), A.Get(r, j)
+		if sign < 0 {
+			//Div only rounds toward -∞ for a positive divisor
+			num.Neg(num)
+			den.Neg(den)
+		}
+		bound := new(big.Int).Div(num, den)
+		if best == nil || bound.Cmp(best) < 0 {
+			best = bound
+		}
+	}
+	return best
+}
@@ -0,0 +1,74 @@
+package lde
+
+import (
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+	"strconv"
+	"testing"
+)
+
+func TestCount(t *testing.T) {
+	tests := []struct {
+		a        *mat.Mat
+		b        *vec.Vec
+		bounds   Bounds
+		expected int64
+	}{
+		{mat.NewMatRows(vec.NewVecInt64(1, 1, 1)), vec.NewVecInt64(5), nil, 21},
+		{mat.NewMatRows(vec.NewVecInt64(3, 9, 5)), vec.NewVecInt64(20), nil, 3},
+		{mat.NewMatRows(vec.NewVecInt64(-1, -1)), vec.NewVecInt64(-3), nil, 4},
+		{mat.NewMatRows(vec.NewVecInt64(1, 1)), vec.NewVecInt64(5), Bounds{1: {Lo: big.NewInt(2), Hi: big.NewInt(4)}}, 3},
+		{mat.NewMatRows(vec.NewVecInt64(1, -1)), vec.NewVecInt64(0), Bounds{0: {Hi: big.NewInt(10)}, 1: {Hi: big.NewInt(7)}}, 8},
+		{mat.NewMatRows(vec.NewVecInt64(1, 1)), vec.NewVecInt64(-1), nil, 0},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			actual, err := Count(test.a, test.b, test.bounds)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual.Cmp(big.NewInt(test.expected)) != 0 {
+				t.Errorf("expected %v but found %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCount_BruteForce(t *testing.T) {
+	A := mat.NewMatRows(vec.NewVecInt64(2, -3, 1, 4), vec.NewVecInt64(1, 1, -2, 0))
+	b := vec.NewVecInt64(3, 1)
+	bound := int64(6)
+
+	expected := int64(0)
+	for w := int64(0); w <= bound; w++ {
+		for x := int64(0); x <= bound; x++ {
+			for y := int64(0); y <= bound; y++ {
+				for z := int64(0); z <= bound; z++ {
+					if 2*w-3*x+y+4*z == 3 && w+x-2*y == 1 {
+						expected++
+					}
+				}
+			}
+		}
+	}
+
+	bounds := Bounds{}
+	for i := uint(0); i < 4; i++ {
+		bounds[i] = Bound{Hi: big.NewInt(bound)}
+	}
+	actual, err := Count(A, b, bounds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actual.Cmp(big.NewInt(expected)) != 0 {
+		t.Errorf("expected %v but found %v", expected, actual)
+	}
+}
+
+func TestCount_Unbounded(t *testing.T) {
+	_, err := Count(mat.NewMatRows(vec.NewVecInt64(1, -1)), vec.NewVecInt64(0), Bounds{0: {Hi: big.NewInt(3)}})
+	if unbounded, ok := err.(*UnboundedError); !ok || unbounded.Variable != 1 {
+		t.Errorf("expected variable 1 to be unbounded but found %v", err)
+	}
+}
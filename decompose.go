@@ -0,0 +1,144 @@
+package lde
+
+import (
+	"fmt"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+)
+
+//Decomposition is a witness that 𝑥 = M1[Specific] + Σ Coefficients[𝑗]⋅M0[𝑗]
+type Decomposition struct {
+	//Specific is the index of the member of M1 used, or -1 when the specific part is zero
+	Specific int
+	//Coefficients holds the multiple of each member of M0
+	Coefficients []*big.Int
+}
+
+//Decompose finds how 𝑥 is made from the results of NonHomogeneous for A𝑥 = b. For the results of
+// Homogeneous pass a nil b and nil M1. When 𝑥 can not be made a *NotRepresentableError says why.
+func Decompose(A *mat.Mat, b *vec.Vec, x *vec.Vec, M1, M0 []*vec.Vec) (*Decomposition, error) {
+	if err := validate(A); err != nil {
+		return nil, err
+	}
+	rows, cols := A.Shape()
+	if b == nil {
+		b = vec.Zeros(rows)
+	}
+	if err := validateLen("b", b, A); err != nil {
+		return nil, err
+	}
+	if x == nil || x.Len() != cols {
+		found := uint(0)
+		if x != nil {
+			found = x.Len()
+		}
+		return nil, &LengthError{Arg: "x", Expected: cols, Found: found}
+	}
+
+	if !natural(x) {
+		return nil, &NotRepresentableError{Reason: NotNatural, Detail: fmt.Sprintf("%v has a negative value", x)}
+	}
+	if !a(A, x).Equals(b) {
+		return nil, &NotRepresentableError{Reason: NotSolution, Detail: fmt.Sprintf("A⋅%v = %v not %v", x, a(A, x), b)}
+	}
+
+	offset := 0
+	if len(M1) == 0 {
+		//an empty M1 can only stand for zero
+		offset = -1
+	}
+	for i, m1 := range specifics(A, b, M1) {
+		r := x.Sub(m1)
+		if !natural(r) {
+			continue
+		}
+		if coefficients, found := decompose(r, M0); found {
+			return &Decomposition{Specific: i + offset, Coefficients: coefficients}, nil
+		}
+	}
+	return nil, &NotRepresentableError{Reason: NotGenerated, Detail: fmt.Sprintf("%v is not made by the bases", x)}
+}
+
+//decompose finds natural coefficients so r = Σ c𝑗⋅M0[𝑗]. Each member in turn takes away as many of
+// itself as fit in what is left, and only when the rest can not be made is one fewer tried, so the
+// search is as deep as M0 is long whatever the size of r. A member with a negative value or none
+// above zero is never used.
+func decompose(r *vec.Vec, M0 []*vec.Vec) ([]*big.Int, bool) {
+	//multiples[𝑗] is the multiple of M0[𝑗] being tried and rests[𝑗] what was left before taking it
+	multiples := make([]*big.Int, 0, len(M0))
+	rests := make([]*vec.Vec, 0, len(M0))
+	//failed[𝑗] remembers the rests shown to have no decomposition from M0[𝑗:]
+	failed := make([]map[string]bool, len(M0))
+	for j := range failed {
+		failed[j] = make(map[string]bool)
+	}
+
+	rest := r
+	for {
+		j := len(multiples)
+		if rest.Equals(vec.Zeros(0)) {
+			coefficients := make([]*big.Int, len(M0))
+			for k := range coefficients {
+				coefficients[k] = new(big.Int)
+				if k < j {
+					coefficients[k].Set(multiples[k])
+				}
+			}
+			return coefficients, true
+		}
+		if j < len(M0) && !failed[j][rest.String()] {
+			c := largestMultiple(rest, M0[j])
+			multiples = append(multiples, c)
+			rests = append(rests, rest)
+			rest = rest.Sub(M0[j].Scalar(c))
+			continue
+		}
+
+		//rest can not be made so back up to the last member with a smaller multiple left to try
+		for {
+			j = len(multiples) - 1
+			if j < 0 {
+				return nil, false
+			}
+			if multiples[j].Sign() > 0 {
+				multiples[j] = new(big.Int).Sub(multiples[j], big.NewInt(1))
+				rest = rests[j].Sub(M0[j].Scalar(multiples[j]))
+				break
+			}
+			failed[j][rests[j].String()] = true
+			multiples, rests = multiples[:j], rests[:j]
+		}
+	}
+}
+
+//largestMultiple returns the largest 𝑐 with r - 𝑐⋅g natural, which is zero when g has a negative value
+// or none above zero
+func largestMultiple(r, g *vec.Vec) *big.Int {
+	var c *big.Int
+	for i := uint(0); i < g.Len(); i++ {
+		switch g.Get(i).Sign() {
+		case -1:
+			return new(big.Int)
+		case 1:
+			q := new(big.Int).Quo(r.Get(i), g.Get(i))
+			if c == nil || q.Cmp(c) < 0 {
+				c = q
+			}
+		}
+	}
+	if c == nil {
+		return new(big.Int)
+	}
+	return c
+}
+
+//natural reports if every value of v is non-negative
+func natural(v *vec.Vec) bool {
+	for i := uint(0); i < v.Len(); i++ {
+		if v.Get(i).Sign() < 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,98 @@
+package lde
+
+import (
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+	"strconv"
+	"testing"
+)
+
+func TestDecompose(t *testing.T) {
+	A := mat.NewMatRows(vec.NewVecInt64(1, -1))
+	b := vec.NewVecInt64(2)
+	M1, M0 := NonHomogeneous(A, b)
+
+	H := mat.NewMatRows(vec.NewVecInt64(6, -9, 2))
+	bases := Homogeneous(H)
+	limited := Homogeneous(H, NewMaxXLimit(big.NewInt(4)))
+
+	tests := []struct {
+		a              *mat.Mat
+		b, x           *vec.Vec
+		m1, m0         []*vec.Vec
+		expectedReason NotRepresentableReason
+		representable  bool
+	}{
+		{A, b, vec.NewVecInt64(5, 3), M1, M0, 0, true},
+		{A, b, vec.NewVecInt64(2, 0), M1, M0, 0, true},
+		{A, b, vec.NewVecInt64(3, 2), M1, M0, NotSolution, false},
+		{A, b, vec.NewVecInt64(1, -1), M1, M0, NotNatural, false},
+		{H, nil, vec.NewVecInt64(3, 4, 9), nil, bases, 0, true},
+		{H, nil, vec.NewVecInt64(0, 0, 0), nil, bases, 0, true},
+		{H, nil, vec.NewVecInt64(0, 2, 9), nil, limited, NotGenerated, false},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			d, err := Decompose(test.a, test.b, test.x, test.m1, test.m0)
+			if !test.representable {
+				notRepresentable, ok := err.(*NotRepresentableError)
+				if !ok || notRepresentable.Reason != test.expectedReason {
+					t.Errorf("expected %v but found %v", test.expectedReason, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, cols := test.a.Shape()
+			actual := vec.Zeros(cols)
+			if d.Specific >= 0 {
+				actual = test.m1[d.Specific]
+			}
+			for j, c := range d.Coefficients {
+				actual = actual.Add(test.m0[j].Scalar(c))
+			}
+			if !actual.Equals(test.x) {
+				t.Errorf("expected %v but the decomposition %v makes %v", test.x, d, actual)
+			}
+		})
+	}
+}
+
+func TestDecompose_LargeCoefficients(t *testing.T) {
+	A := mat.NewMatRows(vec.NewVecInt64(1, -1))
+	H := mat.NewMatRows(vec.NewVecInt64(6, -9, 2))
+	bases := Homogeneous(H)
+	sum := vec.Zeros(3)
+	for j, v := range bases {
+		sum = sum.Add(v.Scalar(big.NewInt(int64(j+1) * 1000003)))
+	}
+
+	tests := []struct {
+		a  *mat.Mat
+		x  *vec.Vec
+		m0 []*vec.Vec
+	}{
+		{A, vec.NewVecInt64(20000000, 20000000), Homogeneous(A)},
+		{H, sum, bases},
+		{H, sum.Add(vec.NewVecInt64(3, 2, 0)), bases},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			d, err := Decompose(test.a, nil, test.x, nil, test.m0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, cols := test.a.Shape()
+			actual := vec.Zeros(cols)
+			for j, c := range d.Coefficients {
+				actual = actual.Add(test.m0[j].Scalar(c))
+			}
+			if !actual.Equals(test.x) {
+				t.Errorf("expected %v but the decomposition %v makes %v", test.x, d, actual)
+			}
+		})
+	}
+}
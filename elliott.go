@@ -0,0 +1,233 @@
+package lde
+
+import (
+	"context"
+	"github.com/nathanhack/lde/internal"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+	"strings"
+)
+
+//Elliott is an Algorithm using Elliott's method in the form of MacMahon's partition analysis, see
+// PartitionAnalysis. Every solution is the numerator of a term plus multiples of its denominators so
+// the Hilbert basis is the minimal ones among those. It is meant as an independent check of the other
+// algorithms rather than for speed. As with Pottier, Limits only filter the bases found and when ctx is
+// done no bases are returned.
+type Elliott struct{}
+
+//Hilbert computes the Hilbert basis of A𝑥 = 0, see Algorithm
+func (Elliott) Hilbert(ctx context.Context, A *mat.Mat, s *Solver) ([]*vec.Vec, error) {
+	g, err := PartitionAnalysis(ctx, A)
+	if err != nil {
+		return make([]*vec.Vec, 0), err
+	}
+	_, cols := A.Shape()
+	zeros := vec.Zeros(cols)
+	candidates := make([]*vec.Vec, 0)
+	for _, t := range g.Terms {
+		if !t.Numerator.Equals(zeros) {
+			candidates = append(candidates, t.Numerator)
+		}
+		candidates = append(candidates, t.Denominator...)
+	}
+	return minimal(distinct(candidates), func(d *vec.Vec) bool { return natural(d) }), nil
+}
+
+//GeneratingFunction is the sum of z^𝑥 over every natural solution 𝑥 of A𝑥 = 0, where z^𝑥 is z1^𝑥1⋯zn^𝑥n.
+// It is written as a sum of terms that share no solution, each term standing for the solutions made
+// from its numerator and any number of each of its denominators.
+type GeneratingFunction struct {
+	Terms []Term
+}
+
+//Term is z^Numerator / ∏(1 - z^Denominator𝑘), every vector in it solves A𝑥 = 0. Each solution the term
+// stands for is made in just one way.
+type Term struct {
+	Numerator   *vec.Vec
+	Denominator []*vec.Vec
+}
+
+//PartitionAnalysis works out the generating function of the natural solutions of A𝑥 = 0. Following
+// MacMahon it is the part of ∏ 1/(1 - z𝑖λ^(Ae𝑖)) free of λ, which Elliott's method gets at one row at a
+// time. The denominators furthest apart in λ𝑗 are split with 1/((1-P)(1-Q)) = 1/((1-P)(1-PQ)) +
+// Q/((1-PQ)(1-Q)), naming them so the second term has one sign in λ𝑗. Once the signs agree the
+// numerator decides: a term that can no longer reach λ𝑗^0 is dropped, one already there keeps only the
+// denominators free of λ𝑗, and otherwise 1/(1-M) = 1 + M/(1-M) takes a step towards it. If ctx is done
+// first an *IncompleteError is returned.
+func PartitionAnalysis(ctx context.Context, A *mat.Mat) (*GeneratingFunction, error) {
+	if err := validate(A); err != nil {
+		return nil, err
+	}
+	rows, cols := A.Shape()
+
+	//each vector is the z exponents followed by the λ exponents
+	start := elliottTerm{numerator: vec.Zeros(cols + rows)}
+	for i := uint(0); i < cols; i++ {
+		start.denominator = append(start.denominator, lift(vec.Eigen(i).Slice(0, cols), A.GetCol(i)))
+	}
+
+	terms := []elliottTerm{start}
+	for r := uint(0); r < rows; r++ {
+		j := cols + r
+		free := make([]elliottTerm, 0)
+		for len(terms) > 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, &IncompleteError{Err: err}
+			}
+			t := terms[len(terms)-1]
+			terms = terms[:len(terms)-1]
+
+			p, q := t.opposite(j)
+			if p >= 0 {
+				P, Q := t.denominator[p], t.denominator[q]
+				PQ := P.Add(Q)
+				//the numerator gets Q so it should head the same way as PQ
+				if PQ.Get(j).Sign() > 0 {
+					P, Q = Q, P
+				}
+				terms = append(terms,
+					t.replace(p, q, t.numerator, P, PQ),
+					t.replace(p, q, t.numerator.Add(Q), PQ, Q))
+				continue
+			}
+
+			e := t.numerator.Get(j).Sign()
+			k, sign := t.signed(j)
+			switch {
+			case e == 0:
+				free = append(free, t.free(j))
+			case sign == 0 || sign == e:
+				//every solution of the term moves λ𝑗 further from zero
+			default:
+				terms = append(terms,
+					t.replace(k, -1, t.numerator),
+					elliottTerm{numerator: t.numerator.Add(t.denominator[k]), denominator: t.denominator})
+			}
+		}
+		terms = free
+	}
+
+	g := &GeneratingFunction{Terms: make([]Term, len(terms))}
+	for i, t := range terms {
+		g.Terms[i] = Term{Numerator: t.numerator.Slice(0, cols), Denominator: make([]*vec.Vec, len(t.denominator))}
+		for k, d := range t.denominator {
+			g.Terms[i].Denominator[k] = d.Slice(0, cols)
+		}
+	}
+	return g, nil
+}
+
+//CountDegree returns the number of solutions with 𝑥1+⋯+𝑥n = d, the coefficient of t^d once every z𝑖 is t
+func (g *GeneratingFunction) CountDegree(d uint) *big.Int {
+	count := new(big.Int)
+	target := new(big.Int).SetUint64(uint64(d))
+	for _, t := range g.Terms {
+		rest := new(big.Int).Sub(target, degree(t.Numerator))
+		if rest.Sign() < 0 {
+			continue
+		}
+		//the ways of making rest out of the degrees of the denominators
+		n := rest.Uint64()
+		ways := make([]*big.Int, n+1)
+		for i := range ways {
+			ways[i] = new(big.Int)
+		}
+		ways[0].SetInt64(1)
+		for _, m := range t.Denominator {
+			w := degree(m).Uint64()
+			for i := w; i <= n; i++ {
+				ways[i].Add(ways[i], ways[i-w])
+			}
+		}
+		count.Add(count, ways[n])
+	}
+	return count
+}
+
+func (g GeneratingFunction) String() string {
+	if len(g.Terms) == 0 {
+		return "0"
+	}
+	sb := strings.Builder{}
+	for i, t := range g.Terms {
+		if i > 0 {
+			sb.WriteString(" + ")
+		}
+		sb.WriteString("z^")
+		sb.WriteString(t.Numerator.String())
+		if len(t.Denominator) == 0 {
+			continue
+		}
+		sb.WriteString("/(")
+		for _, d := range t.Denominator {
+			sb.WriteString("(1-z^")
+			sb.WriteString(d.String())
+			sb.WriteString(")")
+		}
+		sb.WriteString(")")
+	}
+	return sb.String()
+}
+
+//elliottTerm is z^numerator / ∏(1 - z^denominator𝑘) with the λ exponents carried along after z
+type elliottTerm struct {
+	numerator   *vec.Vec
+	denominator []*vec.Vec
+}
+
+//opposite returns the indexes of the denominators with the largest positive and the largest negative
+// value at j, or -1 when the denominators do not have both
+func (t elliottTerm) opposite(j uint) (p, q int) {
+	p, q = -1, -1
+	for k, d := range t.denominator {
+		switch d.Get(j).Sign() {
+		case 1:
+			if p < 0 || d.Get(j).CmpAbs(t.denominator[p].Get(j)) > 0 {
+				p = k
+			}
+		case -1:
+			if q < 0 || d.Get(j).CmpAbs(t.denominator[q].Get(j)) > 0 {
+				q = k
+			}
+		}
+	}
+	if p < 0 || q < 0 {
+		return -1, -1
+	}
+	return p, q
+}
+
+//signed returns the index of the first denominator with a value at j and that value's sign, or -1
+// and 0 when there is none
+func (t elliottTerm) signed(j uint) (int, int) {
+	for k, d := range t.denominator {
+		if sign := d.Get(j).Sign(); sign != 0 {
+			return k, sign
+		}
+	}
+	return -1, 0
+}
+
+//replace returns a term with the numerator given and the denominators at p and q swapped for added,
+// q may be -1
+func (t elliottTerm) replace(p, q int, numerator *vec.Vec, added ...*vec.Vec) elliottTerm {
+	denominator := make([]*vec.Vec, 0, len(t.denominator)+len(added))
+	for k, d := range t.denominator {
+		if k != p && k != q {
+			denominator = append(denominator, d)
+		}
+	}
+	return elliottTerm{numerator: numerator, denominator: append(denominator, added...)}
+}
+
+//free returns the term with only the denominators that are zero at j
+func (t elliottTerm) free(j uint) elliottTerm {
+	denominator := make([]*vec.Vec, 0, len(t.denominator))
+	for _, d := range t.denominator {
+		if d.Get(j).Cmp(internal.Zero) == 0 {
+			denominator = append(denominator, d)
+		}
+	}
+	return elliottTerm{numerator: t.numerator, denominator: denominator}
+}
@@ -0,0 +1,88 @@
+package lde
+
+import (
+	"context"
+	"errors"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+	"strconv"
+	"testing"
+)
+
+func TestElliott(t *testing.T) {
+	sameAsContejeanDevie(t, Elliott{})
+}
+
+func TestPartitionAnalysis(t *testing.T) {
+	g, err := PartitionAnalysis(context.Background(), mat.NewMatRows(vec.NewVecInt64(1, -1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "z^" + vec.NewVecInt64(0, 0).String() + "/((1-z^" + vec.NewVecInt64(1, 1).String() + "))"
+	if g.String() != expected {
+		t.Errorf("expected %v but found %v", expected, g)
+	}
+}
+
+func TestGeneratingFunction_CountDegree(t *testing.T) {
+	tests := []*mat.Mat{
+		mat.NewMatRows(vec.NewVecInt64(-1, 1, 2, -3), vec.NewVecInt64(-1, 3, -2, -1)),
+		mat.NewMatRows(vec.NewVecInt64(6, -9, 2)),
+		mat.NewMatRows(vec.NewVecInt64(1, 1, -2, -1)),
+		mat.NewMatRows(vec.NewVecInt64(3, 5, -7, -2, 1)),
+		mat.NewMatRows(vec.NewVecInt64(1, 2, 3)),
+	}
+	for i, A := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			g, err := PartitionAnalysis(context.Background(), A)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rows, cols := A.Shape()
+			for _, term := range g.Terms {
+				for _, v := range append([]*vec.Vec{term.Numerator}, term.Denominator...) {
+					if !A.Mul(mat.NewMatCols(v)).GetCol(0).Equals(vec.Zeros(rows)) {
+						t.Fatalf("expected a solution but found %v", v)
+					}
+				}
+			}
+
+			for d := uint(0); d <= 8; d++ {
+				expected := new(big.Int)
+				compositions(cols, d, func(x *vec.Vec) {
+					if A.Mul(mat.NewMatCols(x)).GetCol(0).Equals(vec.Zeros(rows)) {
+						expected.Add(expected, big.NewInt(1))
+					}
+				})
+				if actual := g.CountDegree(d); actual.Cmp(expected) != 0 {
+					t.Errorf("degree %v: expected %v but found %v", d, expected, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestPartitionAnalysis_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := PartitionAnalysis(ctx, mat.NewMatRows(vec.NewVecInt64(6, -9, 2)))
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %v but found %v", context.Canceled, err)
+	}
+}
+
+//compositions calls fn with every natural vector of length n adding up to d
+func compositions(n, d uint, fn func(x *vec.Vec)) {
+	var walk func(x *vec.Vec, i, rest uint)
+	walk = func(x *vec.Vec, i, rest uint) {
+		if i == n-1 {
+			fn(x.Set(i, big.NewInt(int64(rest))))
+			return
+		}
+		for v := uint(0); v <= rest; v++ {
+			walk(x.Set(i, big.NewInt(int64(v))), i+1, rest-v)
+		}
+	}
+	walk(vec.Zeros(n), 0, d)
+}
@@ -0,0 +1,123 @@
+package lde
+
+import (
+	"context"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+)
+
+type maxNorm struct {
+	v *big.Int
+}
+
+//NewMaxNormLimit stops any vector whose values sum to more than n
+func NewMaxNormLimit(n *big.Int) LimitBy {
+	return &maxNorm{v: n}
+}
+
+func (m *maxNorm) Stop(current *vec.Vec) bool {
+	return degree(current).Cmp(m.v) > 0
+}
+
+//NewBoundsLimit stops any vector with a value above its variable's Hi. Lo is ignored as a
+// lower bound can not be used to prune.
+func NewBoundsLimit(bounds Bounds) LimitBy {
+	hi := make(map[uint]*big.Int)
+	for i, b := range bounds {
+		if b.Hi != nil {
+			hi[i] = b.Hi
+		}
+	}
+	return &upperLimit{hi: hi}
+}
+
+//Enumerate calls fn once with every distinct solution of A𝑥 = b that can be made from one member of M1
+// plus any number of members of M0 and that no limit stops. M1 and M0 are the results of NonHomogeneous
+// for A𝑥 = b, for the results of Homogeneous pass a nil b and nil M1. The generators must be naturals so
+// that a stopped vector can not lead back inside the limits. Without limits and with a non-empty M0
+// there is no end to the solutions, so fn or ctx must stop it. Solutions arrive in order of the number
+// of generators used. Returning false from fn stops early; if ctx is done first an *IncompleteError is
+// returned. A malformed A or b returns ErrEmptySystem or a *LengthError.
+func Enumerate(ctx context.Context, A *mat.Mat, b *vec.Vec, M1, M0 []*vec.Vec, fn func(x *vec.Vec) bool, limits ...LimitBy) error {
+	if err := validate(A); err != nil {
+		return err
+	}
+	rows, _ := A.Shape()
+	if b == nil {
+		b = vec.Zeros(rows)
+	}
+	if err := validateLen("b", b, A); err != nil {
+		return err
+	}
+	type item struct {
+		x *vec.Vec
+		//from is the first generator that may still be added, keeping the generators used in order
+		from int
+	}
+
+	generators := make([]*vec.Vec, 0, len(M0))
+	for _, g := range M0 {
+		if !g.Equals(vec.Zeros(0)) {
+			generators = append(generators, g)
+		}
+	}
+	stopped := func(x *vec.Vec) bool {
+		for _, l := range limits {
+			if l.Stop(x) {
+				return true
+			}
+		}
+		return false
+	}
+
+	level := make([]item, 0, len(M1))
+	for _, m1 := range specifics(A, b, M1) {
+		if !stopped(m1) {
+			level = append(level, item{x: m1})
+		}
+	}
+
+	//seen holds the smallest from each solution has been expanded with, a solution reached again with
+	// a smaller from still needs the generators between the two
+	seen := make(map[string]int)
+	for len(level) > 0 {
+		if err := ctx.Err(); err != nil {
+			return &IncompleteError{Err: err}
+		}
+
+		next := make([]item, 0)
+		for _, it := range level {
+			key := it.x.String()
+			to := len(generators)
+			if from, has := seen[key]; has {
+				if from <= it.from {
+					continue
+				}
+				to = from
+			} else if !fn(it.x) {
+				return nil
+			}
+			seen[key] = it.from
+
+			for j := it.from; j < to; j++ {
+				y := it.x.Add(generators[j])
+				if !stopped(y) {
+					next = append(next, item{x: y, from: j})
+				}
+			}
+		}
+		level = next
+	}
+	return nil
+}
+
+//specifics returns the specific solutions M1 of A𝑥 = b stand for. NonHomogeneous leaves zero out of M1
+// when b is zero, so then an empty M1 stands for zero.
+func specifics(A *mat.Mat, b *vec.Vec, M1 []*vec.Vec) []*vec.Vec {
+	if len(M1) == 0 && b.Equals(vec.Zeros(b.Len())) {
+		_, cols := A.Shape()
+		return []*vec.Vec{vec.Zeros(cols)}
+	}
+	return M1
+}
@@ -0,0 +1,136 @@
+package lde
+
+import (
+	"context"
+	"errors"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+	"strconv"
+	"testing"
+)
+
+func TestEnumerate(t *testing.T) {
+	tests := []struct {
+		a        *mat.Mat
+		b        *vec.Vec
+		m1, m0   []*vec.Vec
+		limits   []LimitBy
+		expected []*vec.Vec
+	}{
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, -1)),
+			vec.NewVecInt64(2),
+			[]*vec.Vec{vec.NewVecInt64(2, 0)},
+			[]*vec.Vec{vec.NewVecInt64(1, 1)},
+			[]LimitBy{NewBoundsLimit(Bounds{0: {Hi: big.NewInt(5)}})},
+			[]*vec.Vec{vec.NewVecInt64(2, 0), vec.NewVecInt64(3, 1), vec.NewVecInt64(4, 2), vec.NewVecInt64(5, 3)},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(0, 0)),
+			vec.NewVecInt64(0),
+			[]*vec.Vec{vec.NewVecInt64(0, 0)},
+			[]*vec.Vec{vec.NewVecInt64(1, 0), vec.NewVecInt64(0, 1), vec.NewVecInt64(1, 1)},
+			[]LimitBy{NewMaxNormLimit(big.NewInt(2))},
+			[]*vec.Vec{vec.NewVecInt64(0, 0), vec.NewVecInt64(0, 1), vec.NewVecInt64(0, 2), vec.NewVecInt64(1, 0), vec.NewVecInt64(1, 1), vec.NewVecInt64(2, 0)},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(3, 9, 5)),
+			vec.NewVecInt64(20),
+			[]*vec.Vec{vec.NewVecInt64(0, 0, 4), vec.NewVecInt64(2, 1, 1), vec.NewVecInt64(5, 0, 1)},
+			[]*vec.Vec{},
+			nil,
+			[]*vec.Vec{vec.NewVecInt64(0, 0, 4), vec.NewVecInt64(2, 1, 1), vec.NewVecInt64(5, 0, 1)},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, -1)),
+			vec.NewVecInt64(1),
+			[]*vec.Vec{},
+			[]*vec.Vec{vec.NewVecInt64(1, 1)},
+			nil,
+			[]*vec.Vec{},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, -1)),
+			vec.NewVecInt64(0),
+			[]*vec.Vec{},
+			[]*vec.Vec{vec.NewVecInt64(1, 1)},
+			[]LimitBy{NewMaxXLimit(big.NewInt(3))},
+			[]*vec.Vec{vec.NewVecInt64(0, 0), vec.NewVecInt64(1, 1), vec.NewVecInt64(2, 2)},
+		},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			actual := make([]*vec.Vec, 0)
+			err := Enumerate(context.Background(), test.a, test.b, test.m1, test.m0, func(x *vec.Vec) bool {
+				actual = append(actual, x)
+				return true
+			}, test.limits...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(actual) != len(distinct(actual)) {
+				t.Errorf("expected no duplicates but found %v", actual)
+			}
+			if !equalVecs(test.expected, distinct(actual)) {
+				t.Errorf("expected %v but found %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestEnumerate_BruteForce(t *testing.T) {
+	A := mat.NewMatRows(vec.NewVecInt64(6, -9, 2))
+	bound := int64(12)
+
+	expected := 0
+	for x := int64(0); x <= bound; x++ {
+		for y := int64(0); y <= bound; y++ {
+			for z := int64(0); z <= bound; z++ {
+				if 6*x-9*y+2*z == 0 {
+					expected++
+				}
+			}
+		}
+	}
+
+	actual := 0
+	limit := NewBoundsLimit(Bounds{0: {Hi: big.NewInt(bound)}, 1: {Hi: big.NewInt(bound)}, 2: {Hi: big.NewInt(bound)}})
+	err := Enumerate(context.Background(), A, nil, nil, Homogeneous(A), func(x *vec.Vec) bool {
+		actual++
+		return true
+	}, limit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actual != expected {
+		t.Errorf("expected %v solutions but found %v", expected, actual)
+	}
+}
+
+func TestEnumerate_Stop(t *testing.T) {
+	A := mat.NewMatRows(vec.NewVecInt64(0))
+	m0 := []*vec.Vec{vec.NewVecInt64(1)}
+
+	count := 0
+	err := Enumerate(context.Background(), A, nil, nil, m0, func(x *vec.Vec) bool {
+		count++
+		return count < 10
+	})
+	if err != nil || count != 10 {
+		t.Errorf("expected to stop after 10 solutions but found %v and %v", count, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	count = 0
+	err = Enumerate(ctx, A, nil, nil, m0, func(x *vec.Vec) bool {
+		count++
+		if count == 10 {
+			cancel()
+		}
+		return true
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected canceled but found %v", err)
+	}
+}
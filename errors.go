@@ -0,0 +1,133 @@
+package lde
+
+import (
+	"errors"
+	"fmt"
+)
+
+//ErrNoSolution is returned when a system turns out to have no natural solution
+var ErrNoSolution = errors.New("no solution")
+
+//ErrEmptySystem is returned when a system has no variables
+var ErrEmptySystem = errors.New("empty system")
+
+//LengthError is returned when a vector or slice given with a system does not have the length the
+// system needs, such as a b with a value for each row
+type LengthError struct {
+	//Arg names the argument with the wrong length
+	Arg      string
+	Expected uint
+	Found    uint
+}
+
+func (e *LengthError) Error() string {
+	return fmt.Sprintf("%v must have %v values but found %v", e.Arg, e.Expected, e.Found)
+}
+
+//InputError is returned when a value given with a system is not allowed, such as a negative lower bound
+type InputError struct {
+	//Arg names the argument holding the bad value
+	Arg    string
+	Detail string
+}
+
+func (e *InputError) Error() string {
+	return fmt.Sprintf("invalid %v: %v", e.Arg, e.Detail)
+}
+
+//IncompleteError is returned when a solve was stopped before the search space was exhausted. Any
+// solutions returned with it are minimal but the list may be missing some.
+type IncompleteError struct {
+	Err error
+}
+
+func (e *IncompleteError) Error() string {
+	return "incomplete result: " + e.Err.Error()
+}
+
+func (e *IncompleteError) Unwrap() error {
+	return e.Err
+}
+
+//InfeasibleReason is why A𝑥 = b has no solution
+type InfeasibleReason int
+
+const (
+	//SignPattern means a row's coefficients all share a sign that b𝑖 can not be reached with
+	SignPattern InfeasibleReason = iota
+	//RowGCD means the gcd of a row's coefficients does not divide b𝑖
+	RowGCD
+	//NotInLattice means b is not an integer combination of the columns of A
+	NotInLattice
+)
+
+func (r InfeasibleReason) String() string {
+	switch r {
+	case SignPattern:
+		return "sign pattern"
+	case RowGCD:
+		return "row gcd"
+	case NotInLattice:
+		return "not in lattice"
+	}
+	return fmt.Sprintf("InfeasibleReason(%d)", int(r))
+}
+
+//InfeasibleError is returned when A𝑥 = b is known to have no solution before searching
+type InfeasibleError struct {
+	Reason InfeasibleReason
+	//Row is the offending row, or -1 when the reason is not down to a single row
+	Row    int
+	Detail string
+}
+
+func (e *InfeasibleError) Error() string {
+	if e.Row < 0 {
+		return fmt.Sprintf("no solution (%v): %v", e.Reason, e.Detail)
+	}
+	return fmt.Sprintf("no solution (%v) in row %v: %v", e.Reason, e.Row, e.Detail)
+}
+
+//NotRepresentableReason is why a vector can not be decomposed into the bases
+type NotRepresentableReason int
+
+const (
+	//NotNatural means the vector has a negative value
+	NotNatural NotRepresentableReason = iota
+	//NotSolution means the vector does not solve the system
+	NotSolution
+	//NotGenerated means the vector solves the system but is not made by the bases given, which happens
+	// when the bases came from a search cut short by limits or cancellation
+	NotGenerated
+)
+
+func (r NotRepresentableReason) String() string {
+	switch r {
+	case NotNatural:
+		return "not natural"
+	case NotSolution:
+		return "not a solution"
+	case NotGenerated:
+		return "not generated"
+	}
+	return fmt.Sprintf("NotRepresentableReason(%d)", int(r))
+}
+
+//NotRepresentableError is returned when a vector can not be decomposed into the bases
+type NotRepresentableError struct {
+	Reason NotRepresentableReason
+	Detail string
+}
+
+func (e *NotRepresentableError) Error() string {
+	return fmt.Sprintf("not representable (%v): %v", e.Reason, e.Detail)
+}
+
+//UnboundedError is returned when a variable has no upper bound and none can be worked out from the system
+type UnboundedError struct {
+	Variable uint
+}
+
+func (e *UnboundedError) Error() string {
+	return fmt.Sprintf("variable %v is unbounded", e.Variable)
+}
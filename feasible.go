@@ -0,0 +1,76 @@
+package lde
+
+import (
+	"fmt"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+)
+
+//Feasible runs cheap tests that can prove A𝑥 = b has no natural solution. It returns an
+// *InfeasibleError saying why when one fails. A nil result does not promise a solution exists,
+// only that none of the tests ruled it out.
+func Feasible(A *mat.Mat, b *vec.Vec) error {
+	rows, _ := A.Shape()
+
+	//first the signs, a row of only non-negative values can't make a negative and the reverse
+	for r := uint(0); r < rows; r++ {
+		row := A.GetRow(r)
+		positive, negative := false, false
+		for c := uint(0); c < row.Len(); c++ {
+			switch row.Get(c).Sign() {
+			case 1:
+				positive = true
+			case -1:
+				negative = true
+			}
+		}
+		br := b.Get(r)
+		if !negative && br.Sign() < 0 || !positive && br.Sign() > 0 {
+			return &InfeasibleError{
+				Reason: SignPattern,
+				Row:    int(r),
+				Detail: fmt.Sprintf("%v can not be made from %v", br, row),
+			}
+		}
+	}
+
+	//next each row on its own, the gcd of the row must divide its b
+	for r := uint(0); r < rows; r++ {
+		row := A.GetRow(r)
+		g := new(big.Int)
+		for c := uint(0); c < row.Len(); c++ {
+			g.GCD(nil, nil, g, row.Get(c))
+		}
+		br := b.Get(r)
+		if g.Sign() != 0 && new(big.Int).Rem(br, g).Sign() != 0 {
+			return &InfeasibleError{
+				Reason: RowGCD,
+				Row:    int(r),
+				Detail: fmt.Sprintf("gcd %v does not divide %v", g, br),
+			}
+		}
+	}
+
+	//a zero b is always in the lattice and for a single row the gcd test already decided it, so the
+	// Smith normal form is only worked out when it can tell us more
+	if rows == 1 || b.Equals(vec.Zeros(b.Len())) {
+		return nil
+	}
+
+	//last the whole system, with D = U⋅A⋅V we have A𝑥 = b exactly when D𝑦 = U⋅b has an integer solution
+	D, U, _ := A.SmithNormalForm()
+	Ub := U.Mul(mat.NewMatCols(b.Slice(0, rows))).GetCol(0)
+	for r := uint(0); r < rows; r++ {
+		d := D.Get(r, r)
+		v := Ub.Get(r)
+		if d.Sign() == 0 && v.Sign() != 0 || d.Sign() != 0 && new(big.Int).Rem(v, d).Sign() != 0 {
+			return &InfeasibleError{
+				Reason: NotInLattice,
+				Row:    -1,
+				Detail: fmt.Sprintf("%v is not an integer combination of the columns", b),
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package lde
+
+import (
+	"context"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"strconv"
+	"testing"
+)
+
+func TestFeasible(t *testing.T) {
+	tests := []struct {
+		a          *mat.Mat
+		b          *vec.Vec
+		infeasible bool
+		reason     InfeasibleReason
+		row        int
+	}{
+		{mat.NewMatRows(vec.NewVecInt64(3, 9, 5)), vec.NewVecInt64(20), false, 0, 0},
+		{mat.NewMatRows(vec.NewVecInt64(3, 9, 5)), vec.NewVecInt64(-20), true, SignPattern, 0},
+		{mat.NewMatRows(vec.NewVecInt64(1, -1), vec.NewVecInt64(0, 0)), vec.NewVecInt64(1, 1), true, SignPattern, 1},
+		{mat.NewMatRows(vec.NewVecInt64(1, -1), vec.NewVecInt64(2, -4)), vec.NewVecInt64(1, 3), true, RowGCD, 1},
+		{mat.NewMatRows(vec.NewVecInt64(1, 1), vec.NewVecInt64(1, -1)), vec.NewVecInt64(1, 0), true, NotInLattice, -1},
+		{mat.NewMatRows(vec.NewVecInt64(1, 1), vec.NewVecInt64(1, -1)), vec.NewVecInt64(2, 0), false, 0, 0},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			err := Feasible(test.a, test.b)
+			if !test.infeasible {
+				if err != nil {
+					t.Errorf("expected no error but found %v", err)
+				}
+				return
+			}
+			infeasible, ok := err.(*InfeasibleError)
+			if !ok {
+				t.Fatalf("expected an *InfeasibleError but found %v", err)
+			}
+			if infeasible.Reason != test.reason || infeasible.Row != test.row {
+				t.Errorf("expected %v in row %v but found %v", test.reason, test.row, infeasible)
+			}
+		})
+	}
+}
+
+func TestSolver_NonHomogeneousInfeasible(t *testing.T) {
+	A := mat.NewMatRows(vec.NewVecInt64(2, -2))
+	b := vec.NewVecInt64(1)
+
+	M1, M0, err := (&Solver{}).NonHomogeneous(context.Background(), A, b)
+	if _, ok := err.(*InfeasibleError); !ok {
+		t.Errorf("expected an *InfeasibleError but found %v", err)
+	}
+	if len(M1) != 0 || len(M0) != 0 {
+		t.Errorf("expected no solutions but found %v and %v", M1, M0)
+	}
+
+	//the package level function still reports the homogeneous bases
+	M1, M0 = NonHomogeneous(A, b)
+	if len(M1) != 0 || !equalVecs([]*vec.Vec{vec.NewVecInt64(1, 1)}, M0) {
+		t.Errorf("expected no specific solutions and {1 1} but found %v and %v", M1, M0)
+	}
+}
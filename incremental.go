@@ -0,0 +1,157 @@
+package lde
+
+import (
+	"context"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+)
+
+//Incremental keeps the minimal bases of A𝑥 = 0 so the system can be grown one equation at a time.
+// Every solution of the grown system is a combination of the current bases, so the new bases are
+// found by solving a single equation over the combinations instead of starting again from the unit
+// vectors.
+type Incremental struct {
+	//Solver is used for each step, its limits see vectors in the original variables
+	Solver Solver
+
+	rows  []*vec.Vec
+	cols  uint
+	bases []*vec.Vec
+}
+
+//NewIncremental creates an Incremental for cols variables and no equations, so the bases start
+// as the unit vectors.
+func NewIncremental(cols uint) *Incremental {
+	bases := make([]*vec.Vec, 0, cols)
+	for i := uint(0); i < cols; i++ {
+		bases = append(bases, vec.Eigen(i).Slice(0, cols))
+	}
+	return &Incremental{
+		rows:  make([]*vec.Vec, 0),
+		cols:  cols,
+		bases: distinct(bases),
+	}
+}
+
+//Bases returns the minimal bases of the system so far sorted by the Solver's Order
+func (inc *Incremental) Bases() []*vec.Vec {
+	return inc.Solver.order(append([]*vec.Vec{}, inc.bases...))
+}
+
+//Mat returns the equations added so far as a matrix
+func (inc *Incremental) Mat() *mat.Mat {
+	if len(inc.rows) == 0 {
+		return mat.NewMat(0, inc.cols)
+	}
+	return mat.NewMatRows(inc.rows...)
+}
+
+//AddRow appends the equation row⋅𝑥 = 0 and updates the bases. If ctx is done before the new bases
+// are known the system is left as it was and an *IncompleteError is returned.
+func (inc *Incremental) AddRow(ctx context.Context, row *vec.Vec) error {
+	if row.Len() > inc.cols {
+		return &LengthError{Arg: "row", Expected: inc.cols, Found: row.Len()}
+	}
+	row = row.Slice(0, inc.cols)
+	if len(inc.bases) == 0 {
+		//only zero solves the system so it also solves the grown one
+		inc.rows = append(inc.rows, row)
+		return nil
+	}
+
+	//with 𝑥 = Σ λ𝑗h𝑗 over the current bases h𝑗 the new equation is Σ λ𝑗(row⋅h𝑗) = 0
+	c := make([]*vec.Vec, len(inc.bases))
+	for j, h := range inc.bases {
+		c[j] = vec.NewVec(row.Dot(h))
+	}
+
+	combined := inc.Solver
+	combined.Checkpoint = nil
+	combined.Limits = make([]LimitBy, len(inc.Solver.Limits))
+	for i, l := range inc.Solver.Limits {
+		combined.Limits[i] = &combinationLimit{limit: l, bases: inc.bases}
+	}
+	λs, err := combined.Homogeneous(ctx, mat.NewMatCols(c...))
+	if err != nil {
+		return err
+	}
+
+	candidates := make([]*vec.Vec, 0, len(λs))
+	for _, λ := range λs {
+		candidates = append(candidates, combine(λ, inc.bases, inc.cols))
+	}
+	candidates = distinct(candidates)
+
+	//every minimal basis is among the candidates but some candidates are not minimal
+	bases := make([]*vec.Vec, 0, len(candidates))
+	for _, x := range candidates {
+		if !containedInMinimalSet(x, candidates) {
+			bases = append(bases, x)
+		}
+	}
+
+	inc.rows = append(inc.rows, row)
+	inc.bases = bases
+	return nil
+}
+
+//AddColumns appends a variable for each column, column𝑖 holding its coefficient in each equation,
+// and updates the bases. The current bases padded with zeros stay minimal, so they seed the search
+// and only solutions that use a new variable are explored. If ctx is done before the new bases are
+// known the system is left as it was and an *IncompleteError is returned.
+func (inc *Incremental) AddColumns(ctx context.Context, columns ...*vec.Vec) error {
+	n := inc.cols + uint(len(columns))
+	rows := make([]*vec.Vec, len(inc.rows))
+	for r, row := range inc.rows {
+		rows[r] = row.Slice(0, n)
+	}
+	for c, column := range columns {
+		if column.Len() > uint(len(rows)) {
+			return &LengthError{Arg: "column", Expected: uint(len(rows)), Found: column.Len()}
+		}
+		for r := range rows {
+			rows[r] = rows[r].Set(inc.cols+uint(c), column.Get(uint(r)))
+		}
+	}
+	A := mat.NewMat(0, n)
+	if len(rows) > 0 {
+		A = mat.NewMatRows(rows...)
+	}
+
+	seeds := make([]*vec.Vec, len(inc.bases))
+	for i, b := range inc.bases {
+		seeds[i] = b.Slice(0, n)
+	}
+
+	//as in Homogeneous each e𝑖 only grows with e𝑗 for 𝑗 ≤ 𝑖, so starting at the new variables finds
+	// every minimal solution that uses one of them
+	𝓟, eigens := homogeneousStart(A)
+	bases, err := inc.Solver.homogeneous(ctx, A, frontier{level: 1, 𝓟: 𝓟[inc.cols:], 𝓑: seeds}, eigens, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	inc.rows = rows
+	inc.cols = n
+	inc.bases = bases
+	return nil
+}
+
+//combine returns Σ λ𝑗h𝑗 as a vector of length n
+func combine(λ *vec.Vec, bases []*vec.Vec, n uint) *vec.Vec {
+	x := vec.Zeros(n)
+	for j, h := range bases {
+		x = x.Add(h.Scalar(λ.Get(uint(j))))
+	}
+	return x
+}
+
+//combinationLimit applies a limit to Σ λ𝑗h𝑗 rather than to λ
+type combinationLimit struct {
+	limit LimitBy
+	bases []*vec.Vec
+}
+
+func (c *combinationLimit) Stop(current *vec.Vec) bool {
+	return c.limit.Stop(combine(current, c.bases, c.bases[0].Len()))
+}
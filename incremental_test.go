@@ -0,0 +1,87 @@
+package lde
+
+import (
+	"context"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+	"strconv"
+	"testing"
+)
+
+func TestIncremental_AddRow(t *testing.T) {
+	tests := []struct {
+		rows   []*vec.Vec
+		limits []LimitBy
+	}{
+		{[]*vec.Vec{vec.NewVecInt64(-1, 1, 2, -3), vec.NewVecInt64(-1, 3, -2, -1)}, nil},
+		{[]*vec.Vec{vec.NewVecInt64(6, -9, 2)}, nil},
+		{[]*vec.Vec{vec.NewVecInt64(6, -9, 2)}, []LimitBy{NewMaxXLimit(big.NewInt(4))}},
+		{[]*vec.Vec{vec.NewVecInt64(1, 2, -3, 0), vec.NewVecInt64(0, 1, 1, -2), vec.NewVecInt64(2, 0, -1, -1)}, nil},
+		{[]*vec.Vec{vec.NewVecInt64(1, 1), vec.NewVecInt64(1, -1)}, nil},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			inc := NewIncremental(test.rows[0].Len())
+			inc.Solver.Limits = test.limits
+			for _, row := range test.rows {
+				if err := inc.AddRow(context.Background(), row); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			expected := Homogeneous(mat.NewMatRows(test.rows...), test.limits...)
+			if !equalVecs(expected, inc.Bases()) {
+				t.Errorf("expected %v but found %v", expected, inc.Bases())
+			}
+			if !inc.Mat().Equals(mat.NewMatRows(test.rows...)) {
+				t.Errorf("expected %v but found %v", mat.NewMatRows(test.rows...), inc.Mat())
+			}
+		})
+	}
+}
+
+func TestIncremental_AddColumns(t *testing.T) {
+	tests := []struct {
+		rows    []*vec.Vec
+		columns []*vec.Vec
+		full    *mat.Mat
+	}{
+		{
+			[]*vec.Vec{vec.NewVecInt64(6, -9)},
+			[]*vec.Vec{vec.NewVecInt64(2)},
+			mat.NewMatRows(vec.NewVecInt64(6, -9, 2)),
+		},
+		{
+			[]*vec.Vec{vec.NewVecInt64(-1, 1), vec.NewVecInt64(-1, 3)},
+			[]*vec.Vec{vec.NewVecInt64(2, -2), vec.NewVecInt64(-3, -1)},
+			mat.NewMatRows(vec.NewVecInt64(-1, 1, 2, -3), vec.NewVecInt64(-1, 3, -2, -1)),
+		},
+		{
+			[]*vec.Vec{vec.NewVecInt64(1, 2, -3)},
+			[]*vec.Vec{vec.NewVecInt64(0)},
+			mat.NewMatRows(vec.NewVecInt64(1, 2, -3, 0)),
+		},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			inc := NewIncremental(test.rows[0].Len())
+			for _, row := range test.rows {
+				if err := inc.AddRow(context.Background(), row); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := inc.AddColumns(context.Background(), test.columns...); err != nil {
+				t.Fatal(err)
+			}
+
+			expected := Homogeneous(test.full)
+			if !equalVecs(expected, inc.Bases()) {
+				t.Errorf("expected %v but found %v", expected, inc.Bases())
+			}
+			if !inc.Mat().Equals(test.full) {
+				t.Errorf("expected %v but found %v", test.full, inc.Mat())
+			}
+		})
+	}
+}
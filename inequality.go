@@ -0,0 +1,158 @@
+package lde
+
+import (
+	"context"
+	"fmt"
+	"github.com/nathanhack/lde/internal"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"sort"
+)
+
+//Relation is how the left side of a row compares to its right side
+type Relation int
+
+const (
+	//Equal is A𝑥 = b
+	Equal Relation = iota
+	//LessEqual is A𝑥 ≤ b
+	LessEqual
+	//GreaterEqual is A𝑥 ≥ b
+	GreaterEqual
+)
+
+func (r Relation) String() string {
+	switch r {
+	case Equal:
+		return "="
+	case LessEqual:
+		return "≤"
+	case GreaterEqual:
+		return "≥"
+	}
+	return fmt.Sprintf("Relation(%d)", int(r))
+}
+
+//Inequality solves the mixed system where row 𝑖 of A𝑥 relates to b𝑖 by rel[𝑖]. It returns the minimal
+// specific solutions (M1) and the generators of the recession cone (M0) in the original variables,
+// every solution is one from M1 plus any number from M0. Each ≤ row is made an equation by adding a
+// slack variable and each ≥ row by taking one away, the slacks are dropped from the results and the
+// limits only see the original variables.
+func Inequality(A *mat.Mat, rel []Relation, b *vec.Vec, limits ...LimitBy) (M1 []*vec.Vec, M0 []*vec.Vec) {
+	M1, M0, err := (&Solver{Limits: limits}).Inequality(context.Background(), A, rel, b)
+	mustBeValid(err)
+	return M1, M0
+}
+
+//Inequality solves the mixed system where row 𝑖 of A𝑥 relates to b𝑖 by rel[𝑖], see the package level
+// Inequality.
+func (s *Solver) Inequality(ctx context.Context, A *mat.Mat, rel []Relation, b *vec.Vec) (M1 []*vec.Vec, M0 []*vec.Vec, err error) {
+	if err := validateB(A, b); err != nil {
+		return make([]*vec.Vec, 0), make([]*vec.Vec, 0), err
+	}
+	rows, cols := A.Shape()
+	if uint(len(rel)) != rows {
+		return make([]*vec.Vec, 0), make([]*vec.Vec, 0), &LengthError{Arg: "rel", Expected: rows, Found: uint(len(rel))}
+	}
+
+	//every inequality gets its own slack column, +1 to take up the gap below b and -1 for above
+	c := A.GetCols()
+	for r, relation := range rel {
+		switch relation {
+		case Equal:
+		case LessEqual:
+			c = append(c, vec.Zeros(rows).Set(uint(r), internal.One))
+		case GreaterEqual:
+			c = append(c, vec.Zeros(rows).Set(uint(r), internal.NegOne))
+		default:
+			return make([]*vec.Vec, 0), make([]*vec.Vec, 0), &InputError{Arg: "rel", Detail: fmt.Sprintf("unknown relation %v for row %v", relation, r)}
+		}
+	}
+
+	//the limits are applied to the extended system so we hide the slack columns from them
+	slacked := *s
+	slacked.Checkpoint = nil
+	slacked.Limits = make([]LimitBy, len(s.Limits))
+	for i, l := range s.Limits {
+		slacked.Limits[i] = &sliceLimit{limit: l, start: 0, end: cols}
+	}
+
+	M1, M0, err = slacked.NonHomogeneous(ctx, mat.NewMatCols(c...), b)
+
+	inCone := func(d *vec.Vec) bool {
+		if !natural(d) {
+			return false
+		}
+		ad := a(A, d)
+		for r, relation := range rel {
+			sign := ad.Get(uint(r)).Sign()
+			if relation == Equal && sign != 0 || relation == LessEqual && sign > 0 || relation == GreaterEqual && sign < 0 {
+				return false
+			}
+		}
+		return true
+	}
+	M1 = minimal(project(M1, cols), inCone)
+	M0 = minimal(project(M0, cols), inCone)
+	return s.order(M1), s.order(M0), err
+}
+
+//project returns the distinct first n values of each vector in sorted order
+func project(vs []*vec.Vec, n uint) []*vec.Vec {
+	projected := make([]*vec.Vec, len(vs))
+	for i, v := range vs {
+		projected[i] = v.Slice(0, n)
+	}
+	return distinct(projected)
+}
+
+//distinct returns the vectors without duplicates in sorted order
+func distinct(vs []*vec.Vec) []*vec.Vec {
+	seen := make(map[string]bool)
+	unique := make([]*vec.Vec, 0, len(vs))
+	for _, v := range vs {
+		key := v.String()
+		if !seen[key] {
+			seen[key] = true
+			unique = append(unique, v)
+		}
+	}
+	sort.Slice(unique, func(i, j int) bool {
+		return unique[i].Cmp(unique[j]) < 0
+	})
+	return unique
+}
+
+//minimal removes each vector that is another kept vector plus a non-zero member of the cone, inCone
+// reports if a difference is in that cone. For specific solutions any cone works as the generators
+// are left untouched, for the generators themselves the cone must be pointed so a removed generator
+// can always be rebuilt from smaller ones.
+func minimal(vs []*vec.Vec, inCone func(d *vec.Vec) bool) []*vec.Vec {
+	return reduce(vs, func(x, y *vec.Vec) bool {
+		return inCone(x.Sub(y))
+	})
+}
+
+//reduce removes each x for which reducible(x, y) is true for some other y still kept
+func reduce(vs []*vec.Vec, reducible func(x, y *vec.Vec) bool) []*vec.Vec {
+	kept := make([]bool, len(vs))
+	for i := range kept {
+		kept[i] = true
+	}
+	for i, x := range vs {
+		for j, y := range vs {
+			if i != j && kept[j] && reducible(x, y) {
+				kept[i] = false
+				break
+			}
+		}
+	}
+
+	reduced := make([]*vec.Vec, 0, len(vs))
+	for i, v := range vs {
+		if kept[i] {
+			reduced = append(reduced, v)
+		}
+	}
+	return reduced
+}
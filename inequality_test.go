@@ -0,0 +1,72 @@
+package lde
+
+import (
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+	"strconv"
+	"testing"
+)
+
+func TestInequality(t *testing.T) {
+	tests := []struct {
+		a          *mat.Mat
+		rel        []Relation
+		b          *vec.Vec
+		limits     []LimitBy
+		expectedM1 []*vec.Vec
+		expectedM0 []*vec.Vec
+	}{
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, 1)),
+			[]Relation{LessEqual},
+			vec.NewVecInt64(2),
+			nil,
+			[]*vec.Vec{vec.NewVecInt64(0, 0), vec.NewVecInt64(0, 1), vec.NewVecInt64(0, 2), vec.NewVecInt64(1, 0), vec.NewVecInt64(1, 1), vec.NewVecInt64(2, 0)},
+			[]*vec.Vec{},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, -1)),
+			[]Relation{GreaterEqual},
+			vec.NewVecInt64(1),
+			nil,
+			[]*vec.Vec{vec.NewVecInt64(1, 0)},
+			[]*vec.Vec{vec.NewVecInt64(1, 0), vec.NewVecInt64(1, 1)},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, 1), vec.NewVecInt64(1, 0)),
+			[]Relation{Equal, LessEqual},
+			vec.NewVecInt64(3, 1),
+			nil,
+			[]*vec.Vec{vec.NewVecInt64(0, 3), vec.NewVecInt64(1, 2)},
+			[]*vec.Vec{},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, 1)),
+			[]Relation{GreaterEqual},
+			vec.NewVecInt64(2),
+			nil,
+			[]*vec.Vec{vec.NewVecInt64(0, 2), vec.NewVecInt64(1, 1), vec.NewVecInt64(2, 0)},
+			[]*vec.Vec{vec.NewVecInt64(0, 1), vec.NewVecInt64(1, 0)},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, 1)),
+			[]Relation{LessEqual},
+			vec.NewVecInt64(3),
+			[]LimitBy{NewMaxXLimit(big.NewInt(2))},
+			[]*vec.Vec{vec.NewVecInt64(0, 0), vec.NewVecInt64(0, 1), vec.NewVecInt64(1, 0), vec.NewVecInt64(1, 1)},
+			[]*vec.Vec{},
+		},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			actualM1, actualM0 := Inequality(test.a, test.rel, test.b, test.limits...)
+			if !equalVecs(test.expectedM1, actualM1) {
+				t.Errorf("expected %v but found %v", test.expectedM1, actualM1)
+			}
+			if !equalVecs(test.expectedM0, actualM0) {
+				t.Errorf("expected %v but found %v", test.expectedM0, actualM0)
+			}
+		})
+	}
+}
@@ -1,12 +1,13 @@
 package lde
 
 import (
-	"fmt"
+	"context"
 	"github.com/nathanhack/lde/internal"
 	"github.com/nathanhack/lde/mat"
 	"github.com/nathanhack/lde/vec"
 	"math/big"
 	"sort"
+	"time"
 )
 
 type fvec struct {
@@ -37,52 +38,139 @@ func (m *maxX) Stop(current *vec.Vec) bool {
 //Solves A𝑥 = 0, returns the minimal bases. Each basis can be added in linear
 // combination with other bases to construct new solutions.
 func Homogeneous(A *mat.Mat, limits ...LimitBy) []*vec.Vec {
-	//first we create a set of basis vectors
-	𝓟 := make([]*fvec, 0)
+	𝓑, err := (&Solver{Limits: limits}).Homogeneous(context.Background(), A)
+	mustBeValid(err)
+	return 𝓑
+}
+
+//HomogeneousContext solves A𝑥 = 0 like Homogeneous but stops when ctx is done. In that case the bases
+// found so far are returned together with an *IncompleteError.
+func HomogeneousContext(ctx context.Context, A *mat.Mat, limits ...LimitBy) ([]*vec.Vec, error) {
+	return (&Solver{Limits: limits}).Homogeneous(ctx, A)
+}
+
+//HomogeneousFunc solves A𝑥 = 0 like Homogeneous but hands each minimal basis to fn as soon as it is
+// found instead of collecting them. Bases arrive in order of increasing size, not sorted. Returning
+// false from fn stops the search early.
+func HomogeneousFunc(A *mat.Mat, fn func(basis *vec.Vec) bool, limits ...LimitBy) {
+	mustBeValid((&Solver{Limits: limits}).HomogeneousFunc(context.Background(), A, fn))
+}
+
+//homogeneousStart creates the starting set of basis vectors for A𝑥 = 0
+func homogeneousStart(A *mat.Mat) ([]*fvec, []*vec.Vec) {
 	_, cols := A.Shape()
-	eigens := make(map[uint]*vec.Vec, 0)
+	𝓟 := make([]*fvec, 0, cols)
+	eigens := make([]*vec.Vec, 0, cols)
 	for i := uint(0); i < cols; i++ {
 		v := vec.Eigen(i).Slice(0, cols)
 		𝓟 = append(𝓟, &fvec{
 			v: v,
 			f: vec.Ones(cols).Sub(vec.Ones(i + 1)),
 		})
-		eigens[i] = v
+		eigens = append(eigens, v)
 	}
-	return homogeneous(A, 𝓟, eigens, limits...)
+	return 𝓟, eigens
 }
 
-func homogeneous(A *mat.Mat, 𝓟 []*fvec, eigens map[uint]*vec.Vec, limits ...LimitBy) []*vec.Vec {
-	𝓑 := make([]*vec.Vec, 0)
+//homogeneous runs the search from the frontier. The bases already in its 𝓑 are minimal, they start off 𝓑
+// but are not passed to emit. When emit is not nil it is called with each new member of 𝓑 and the
+// search stops as soon as it returns false. When snapshot is not nil it is filled in and handed to the
+// solver's Checkpoint at the start of each level. If ctx is done before the search space is exhausted
+// the sorted 𝓑 found so far is returned with an *IncompleteError.
+func (s *Solver) homogeneous(ctx context.Context, A *mat.Mat, from frontier, eigens []*vec.Vec, snapshot *Snapshot, emit func(*vec.Vec) bool) ([]*vec.Vec, error) {
+	//the starting vectors never went through expand so the limits have not seen them yet
+	𝓟 := make([]*fvec, 0, len(from.𝓟))
+	startPruned := 0
+	for _, 𝑥 := range from.𝓟 {
+		if s.stopped(𝑥.v) {
+			startPruned++
+			continue
+		}
+		𝓟 = append(𝓟, 𝑥)
+	}
+	𝓑 := make([]*vec.Vec, 0, len(from.𝓑))
 	𝓑Map := make(map[string]bool)
+	for _, v := range from.𝓑 {
+		𝓑 = append(𝓑, v)
+		𝓑Map[v.String()] = true
+	}
 	_, cols := A.Shape()
 	zeroVec := vec.Zeros(cols)
-	for len(𝓟) > 0 {
+
+	//a(e𝑖) never changes so we only work it out once
+	aEigens := make([]*vec.Vec, len(eigens))
+	for i, e𝑖 := range eigens {
+		aEigens[i] = a(A, e𝑖)
+	}
+
+	var err error
+	for level := from.level; len(𝓟) > 0; level++ {
+		//we only check between levels so each level is either fully processed or not at all
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			err = &IncompleteError{Err: ctxErr}
+			break
+		}
+		if snapshot != nil {
+			if checkpointErr := s.Checkpoint(snapshot.at(frontier{level: level, 𝓟: 𝓟, 𝓑: 𝓑})); checkpointErr != nil {
+				err = &IncompleteError{Err: checkpointErr}
+				break
+			}
+		}
+		stats := LevelStats{Level: level, Frontier: len(𝓟), Pruned: startPruned}
+		startPruned = 0
+		start := time.Now()
+
 		//fist we 𝓑 := 𝓑 ⋃ {𝑥 ∈ 𝓟 | a(𝑥) = 0}
 		// which means we add to 𝓑 any non-dup 𝑥 from 𝓟 that solves the equation
 
+		a𝓟 := make([]*vec.Vec, len(𝓟))
+		s.each(len(𝓟), func(i int) {
+			a𝓟[i] = a(A, 𝓟[i].v)
+		})
+
+		stopped := false
 		𝓟Not𝓑 := make([]*fvec, 0)
-		for _, v := range 𝓟 {
-			if a(A, v.v).Equals(zeroVec) {
+		a𝓟Not𝓑 := make([]*vec.Vec, 0)
+		for i, v := range 𝓟 {
+			if a𝓟[i].Equals(zeroVec) {
 				//we only add it if it's new
-				s := v.v.String()
-				if _, has := 𝓑Map[s]; !has {
+				key := v.v.String()
+				if _, has := 𝓑Map[key]; !has {
 					𝓑 = append(𝓑, v.v)
-					𝓑Map[s] = true
+					𝓑Map[key] = true
+					stats.Solutions++
+					if emit != nil && !emit(v.v) {
+						stopped = true
+						break
+					}
 				}
 			} else {
 				//we put it in 𝓟Not𝓑 for later use
 				𝓟Not𝓑 = append(𝓟Not𝓑, v)
+				a𝓟Not𝓑 = append(a𝓟Not𝓑, a𝓟[i])
 			}
 		}
+		if stopped {
+			s.observe(stats, start)
+			break
+		}
 
 		//next we make 𝓠, 𝓠 := { 𝑥 ∈ 𝓟 \ 𝓑 | ∀s ∈ 𝓑, 𝑥 not(⨠)s}
 		// which mean we make 𝓠 with everything in 𝓟 not in 𝓑 that is not contained in 𝓑
 
+		contained := make([]bool, len(𝓟Not𝓑))
+		s.each(len(𝓟Not𝓑), func(i int) {
+			contained[i] = containedInMinimalSet(𝓟Not𝓑[i].v, 𝓑)
+		})
+
 		𝓠 := make([]*fvec, 0)
-		for _, v := range 𝓟Not𝓑 {
-			if !containedInMinimalSet(v.v, 𝓑) {
+		a𝓠 := make([]*vec.Vec, 0)
+		for i, v := range 𝓟Not𝓑 {
+			if !contained[i] {
 				𝓠 = append(𝓠, v)
+				a𝓠 = append(a𝓠, a𝓟Not𝓑[i])
+			} else {
+				stats.Contained++
 			}
 		}
 
@@ -91,30 +179,18 @@ func homogeneous(A *mat.Mat, 𝓟 []*fvec, eigens map[uint]*vec.Vec, limits ...L
 		// take each vec in 𝓠 and each eigen vector and if a(𝑥)⋅a(e𝑖) < 0 is true
 		// then add 𝑥 and e𝑖 and put that in 𝓟
 
+		next := make([][]*fvec, len(𝓠))
+		pruned := make([]int, len(𝓠))
+		s.each(len(𝓠), func(i int) {
+			next[i], pruned[i] = s.expand(𝓠[i], a𝓠[i], eigens, aEigens)
+		})
+
 		𝓟 = make([]*fvec, 0)
-		for _, 𝑥 := range 𝓠 {
-			a𝑥 := a(A, 𝑥.v)
-			frozen := vec.Zeros(cols)
-		eigenLoop:
-			for _, e𝑖 := range eigens {
-				//if not frozen
-				if 𝑥.f.Dot(e𝑖).Cmp(internal.Zero) == 0 {
-					if a𝑥.Dot(a(A, e𝑖)).Cmp(internal.Zero) < 0 {
-						nv := 𝑥.v.Add(e𝑖)
-						for _, l := range limits {
-							if l.Stop(nv) {
-								continue eigenLoop
-							}
-						}
-						𝓟 = append(𝓟, &fvec{
-							v: nv,
-							f: 𝑥.f.Add(frozen),
-						})
-						frozen = frozen.Add(e𝑖)
-					}
-				}
-			}
+		for i, n := range next {
+			𝓟 = append(𝓟, n...)
+			stats.Pruned += pruned[i]
 		}
+		s.observe(stats, start)
 	}
 
 	//we'll sort 𝓑 before returning it
@@ -123,12 +199,73 @@ func homogeneous(A *mat.Mat, 𝓟 []*fvec, eigens map[uint]*vec.Vec, limits ...L
 		return 𝓑[i].Cmp(𝓑[j]) < 0
 	})
 
-	return 𝓑
+	return 𝓑, err
+}
+
+//expand returns the children of 𝑥 for the next level, 𝑥 + e𝑖 for every e𝑖 not frozen in 𝑥 with a(𝑥)⋅a(e𝑖) < 0,
+// along with how many children a limit stopped
+func (s *Solver) expand(𝑥 *fvec, a𝑥 *vec.Vec, eigens []*vec.Vec, aEigens []*vec.Vec) (children []*fvec, pruned int) {
+	children = make([]*fvec, 0)
+	frozen := vec.Zeros(𝑥.v.Len())
+	for i, e𝑖 := range eigens {
+		//if not frozen
+		if 𝑥.f.Dot(e𝑖).Cmp(internal.Zero) == 0 {
+			if a𝑥.Dot(aEigens[i]).Cmp(internal.Zero) < 0 {
+				nv := 𝑥.v.Add(e𝑖)
+				if s.stopped(nv) {
+					pruned++
+					continue
+				}
+				children = append(children, &fvec{
+					v: nv,
+					f: 𝑥.f.Add(frozen),
+				})
+				frozen = frozen.Add(e𝑖)
+			}
+		}
+	}
+	return children, pruned
 }
 
 //NonHomogeneous solve the A𝑥 = b equation. Returns the set of specific solutions (M1) and the homogeneous bases (M0).
 // all solutions can be made by taking one from M1 and adding any number the bases from M0 (aka M1+ M0 + M0+...)
+// When b is zero M1 is empty and zero is the only specific solution.
+// The limits see [𝑥0|𝑥] where 𝑥0 is 1 for members of M1 and 0 for members of M0, unlike the limits of a
+// Solver which see 𝑥 alone. A b without a value for each row of A panics with a *LengthError.
 func NonHomogeneous(A *mat.Mat, b *vec.Vec, limits ...LimitBy) (M1 []*vec.Vec, M0 []*vec.Vec) {
+	s := &Solver{Limits: limits, extended: true}
+	M1, M0, err := s.NonHomogeneous(context.Background(), A, b)
+	mustBeValid(err)
+	if _, infeasible := err.(*InfeasibleError); infeasible {
+		//there are no specific solutions but the homogeneous bases are still wanted
+		M1, M0, _ = s.NonHomogeneous(context.Background(), A, vec.Zeros(b.Len()))
+	}
+	return M1, M0
+}
+
+//NonHomogeneousContext solves A𝑥 = b like NonHomogeneous but stops when ctx is done. In that case the
+// solutions found so far are returned together with an *IncompleteError. If Feasible rules out any
+// solution nothing is searched and its *InfeasibleError is returned. The limits see [𝑥0|𝑥] as for
+// NonHomogeneous.
+func NonHomogeneousContext(ctx context.Context, A *mat.Mat, b *vec.Vec, limits ...LimitBy) (M1 []*vec.Vec, M0 []*vec.Vec, err error) {
+	return (&Solver{Limits: limits, extended: true}).NonHomogeneous(ctx, A, b)
+}
+
+//NonHomogeneousFunc solves A𝑥 = b like NonHomogeneous but hands each solution to fn as soon as it is
+// found. specific is true for members of M1 and false for members of M0. Returning false from fn
+// stops the search early. The limits see [𝑥0|𝑥] as for NonHomogeneous.
+func NonHomogeneousFunc(A *mat.Mat, b *vec.Vec, fn func(x *vec.Vec, specific bool) bool, limits ...LimitBy) {
+	s := &Solver{Limits: limits, extended: true}
+	err := s.NonHomogeneousFunc(context.Background(), A, b, fn)
+	mustBeValid(err)
+	if _, infeasible := err.(*InfeasibleError); infeasible {
+		//there are no specific solutions but the homogeneous bases are still wanted
+		s.NonHomogeneousFunc(context.Background(), A, vec.Zeros(b.Len()), fn)
+	}
+}
+
+//nonHomogeneousStart creates the matrix and starting set used to solve A𝑥 = b as a homogeneous system
+func nonHomogeneousStart(A *mat.Mat, b *vec.Vec) (*mat.Mat, []*fvec, []*vec.Vec) {
 	// for this case we create a new matrix
 	// with the 0th index column set to b
 	// then with that as A' solve A'𝑥 = 0
@@ -147,7 +284,7 @@ func NonHomogeneous(A *mat.Mat, b *vec.Vec, limits ...LimitBy) (M1 []*vec.Vec, M
 
 	//next we create a set of basis vectors
 	𝓟 := make([]*fvec, 0)
-	eigens := make(map[uint]*vec.Vec, 0)
+	eigens := make([]*vec.Vec, 0, newCols-1)
 	bIsZeroVec := b.Cmp(vec.Zeros(b.Len())) == 0
 	for i := uint(1); i < newCols; i++ {
 		// we freeze the first column
@@ -166,12 +303,13 @@ func NonHomogeneous(A *mat.Mat, b *vec.Vec, limits ...LimitBy) (M1 []*vec.Vec, M
 				f: f,
 			})
 		}
-		eigens[i] = v
+		eigens = append(eigens, v)
 	}
+	return newA, 𝓟, eigens
+}
 
-	𝓑 := homogeneous(newA, 𝓟, eigens, limits...)
-	//𝓑 will contain both the specific and homogeneous values
-	//we'll separate them
+//split separates the bases of the extended system into the specific (M1) and homogeneous (M0) solutions
+func split(𝓑 []*vec.Vec) (M1 []*vec.Vec, M0 []*vec.Vec) {
 	M0 = make([]*vec.Vec, 0)
 	M1 = make([]*vec.Vec, 0)
 
@@ -189,7 +327,7 @@ func NonHomogeneous(A *mat.Mat, b *vec.Vec, limits ...LimitBy) (M1 []*vec.Vec, M
 func a(m *mat.Mat, vec *vec.Vec) *vec.Vec {
 	_, cols := m.Shape()
 	if vec.Len() > cols {
-		panic(fmt.Sprintf("vec must be equal the number of cols in the matrix, expected %v but found %v", cols, vec.Len()))
+		panic(&LengthError{Arg: "vec", Expected: cols, Found: vec.Len()})
 	}
 	m1 := m.Mul(mat.NewMatCols(vec.Slice(0, cols)))
 	return m1.GetCol(0)
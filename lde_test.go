@@ -1,11 +1,15 @@
 package lde
 
 import (
+	"context"
+	"errors"
 	"github.com/nathanhack/lde/mat"
 	"github.com/nathanhack/lde/vec"
 	"math/big"
+	"sort"
 	"strconv"
 	"testing"
+	"time"
 )
 
 func TestA(t *testing.T) {
@@ -127,6 +131,14 @@ func TestNonHomogeneous(t *testing.T) {
 			[]*vec.Vec{},
 			[]*vec.Vec{vec.NewVecInt64(2, 2, 3), vec.NewVecInt64(3, 2, 0)},
 		},
+
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, 1)),
+			vec.NewVecInt64(1),
+			NewBoundsLimit(Bounds{0: {Hi: big.NewInt(0)}}),
+			[]*vec.Vec{},
+			[]*vec.Vec{},
+		},
 	}
 	for i, test := range tests {
 		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
@@ -159,3 +171,101 @@ func TestNonHomogeneous(t *testing.T) {
 		})
 	}
 }
+
+func TestHomogeneousFunc(t *testing.T) {
+	A := mat.NewMatRows(vec.NewVecInt64(6, -9, 2))
+	expected := Homogeneous(A)
+
+	actual := make([]*vec.Vec, 0)
+	HomogeneousFunc(A, func(basis *vec.Vec) bool {
+		actual = append(actual, basis)
+		return true
+	})
+	sort.Slice(actual, func(i, j int) bool {
+		return actual[i].Cmp(actual[j]) < 0
+	})
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %v but found %v", expected, actual)
+	}
+	for i, x := range expected {
+		if actual[i].Cmp(x) != 0 {
+			t.Errorf("expected %v but found %v", x, actual[i])
+		}
+	}
+
+	count := 0
+	HomogeneousFunc(A, func(basis *vec.Vec) bool {
+		count++
+		return false
+	})
+	if count != 1 {
+		t.Errorf("expected the search to stop after 1 basis but found %v", count)
+	}
+}
+
+func TestNonHomogeneousFunc(t *testing.T) {
+	A := mat.NewMatRows(vec.NewVecInt64(1, -1))
+	b := vec.NewVecInt64(2)
+	expectedM1, expectedM0 := NonHomogeneous(A, b)
+
+	actualM1 := make([]*vec.Vec, 0)
+	actualM0 := make([]*vec.Vec, 0)
+	NonHomogeneousFunc(A, b, func(x *vec.Vec, specific bool) bool {
+		if specific {
+			actualM1 = append(actualM1, x)
+		} else {
+			actualM0 = append(actualM0, x)
+		}
+		return true
+	})
+
+	if len(actualM1) != len(expectedM1) || actualM1[0].Cmp(expectedM1[0]) != 0 {
+		t.Errorf("expected %v but found %v", expectedM1, actualM1)
+	}
+	if len(actualM0) != len(expectedM0) || actualM0[0].Cmp(expectedM0[0]) != 0 {
+		t.Errorf("expected %v but found %v", expectedM0, actualM0)
+	}
+}
+
+func TestHomogeneousContext(t *testing.T) {
+	A := mat.NewMatRows(vec.NewVecInt64(6, -9, 2))
+
+	actual, err := HomogeneousContext(context.Background(), A)
+	if err != nil {
+		t.Fatalf("expected no error but found %v", err)
+	}
+	if len(actual) != len(Homogeneous(A)) {
+		t.Errorf("expected %v but found %v", Homogeneous(A), actual)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	actual, err = HomogeneousContext(ctx, A)
+	var incomplete *IncompleteError
+	if !errors.As(err, &incomplete) || !errors.Is(err, context.Canceled) {
+		t.Errorf("expected an incomplete canceled error but found %v", err)
+	}
+	if len(actual) != 0 {
+		t.Errorf("expected no bases but found %v", actual)
+	}
+}
+
+func TestNonHomogeneousContext(t *testing.T) {
+	A := mat.NewMatRows(vec.NewVecInt64(3, 9, 5))
+	b := vec.NewVecInt64(20)
+
+	M1, M0, err := NonHomogeneousContext(context.Background(), A, b)
+	if err != nil {
+		t.Fatalf("expected no error but found %v", err)
+	}
+	if len(M1) != 3 || len(M0) != 0 {
+		t.Errorf("expected 3 specific and 0 homogeneous solutions but found %v and %v", M1, M0)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+	_, _, err = NonHomogeneousContext(ctx, A, b)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded but found %v", err)
+	}
+}
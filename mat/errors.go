@@ -0,0 +1,14 @@
+package mat
+
+import "fmt"
+
+//ShapeError is returned when the shapes given to an operation do not fit together
+type ShapeError struct {
+	//Op is the operation that was given the bad shapes, such as "NewMat" or "Mul"
+	Op     string
+	Detail string
+}
+
+func (e *ShapeError) Error() string {
+	return fmt.Sprintf("%v: shape mismatch %v", e.Op, e.Detail)
+}
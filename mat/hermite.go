@@ -0,0 +1,143 @@
+package mat
+
+import (
+	"github.com/nathanhack/lde/internal"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+)
+
+//Identity returns the n x n identity matrix
+func Identity(n uint) *Mat {
+	t := make([]*big.Int, n*n)
+	for i := uint(0); i < n; i++ {
+		for j := uint(0); j < n; j++ {
+			if i == j {
+				t[n*i+j] = internal.One
+			} else {
+				t[n*i+j] = internal.Zero
+			}
+		}
+	}
+	return NewMat(n, n, t...)
+}
+
+//HermiteNormalForm returns the row style Hermite normal form H of the matrix together with the
+// unimodular matrix U such that H = U⋅m. H is in row echelon form, each pivot is positive and the
+// entries above a pivot are reduced to be non-negative and smaller than the pivot.
+func (m *Mat) HermiteNormalForm() (H, U *Mat) {
+	h := m.table()
+	u := Identity(m.rows).table()
+
+	r := uint(0)
+	for c := uint(0); c < m.cols && r < m.rows; c++ {
+		//fold every row below r into row r until only row r is left with a value in column c
+		for i := r + 1; i < m.rows; i++ {
+			if h[i][c].Sign() == 0 {
+				continue
+			}
+			combineRows(h, u, r, i, h[r][c], h[i][c])
+		}
+		if h[r][c].Sign() == 0 {
+			//no pivot in this column
+			continue
+		}
+		if h[r][c].Sign() < 0 {
+			scaleRow(h[r], internal.NegOne)
+			scaleRow(u[r], internal.NegOne)
+		}
+
+		//reduce the entries above the pivot
+		for i := uint(0); i < r; i++ {
+			q := new(big.Int).Div(h[i][c], h[r][c])
+			subRow(h[i], h[r], q)
+			subRow(u[i], u[r], q)
+		}
+		r++
+	}
+
+	return fromTable(h, m.rows, m.cols), fromTable(u, m.rows, m.rows)
+}
+
+//Kernel returns a basis of the integer lattice {𝑥 | m⋅𝑥 = 0}. Every integer solution is a unique integer
+// combination of the returned vectors.
+func (m *Mat) Kernel() []*vec.Vec {
+	//with H = U⋅mᵀ the rows of U that give the zero rows of H are exactly the kernel
+	H, U := m.T().padded(m.cols, m.rows).HermiteNormalForm()
+	rank := uint(0)
+	for rank < H.rows && !H.GetRow(rank).Equals(vec.Zeros(0)) {
+		rank++
+	}
+
+	kernel := make([]*vec.Vec, 0, H.rows-rank)
+	for i := rank; i < U.rows; i++ {
+		kernel = append(kernel, U.GetRow(i))
+	}
+	return kernel
+}
+
+//padded returns the matrix with the given shape. Set grows a matrix only as far as its
+// values so an all zero row or column at the end can be lost, this puts them back.
+func (m *Mat) padded(rows, cols uint) *Mat {
+	return fromTable(m.table(), rows, cols)
+}
+
+//table returns a copy of the values as rows
+func (m *Mat) table() [][]*big.Int {
+	t := make([][]*big.Int, m.rows)
+	for r := uint(0); r < m.rows; r++ {
+		t[r] = make([]*big.Int, m.cols)
+		for c := uint(0); c < m.cols; c++ {
+			t[r][c] = m.Get(r, c)
+		}
+	}
+	return t
+}
+
+//fromTable creates a rows x cols matrix from t, anything missing from t is zero
+func fromTable(t [][]*big.Int, rows, cols uint) *Mat {
+	v := make([]*big.Int, rows*cols)
+	for r := uint(0); r < rows; r++ {
+		for c := uint(0); c < cols; c++ {
+			if r < uint(len(t)) && c < uint(len(t[r])) {
+				v[cols*r+c] = new(big.Int).Set(t[r][c])
+			} else {
+				v[cols*r+c] = internal.Zero
+			}
+		}
+	}
+	return NewMat(rows, cols, v...)
+}
+
+//combineRows replaces rows i and j of both h and u with a unimodular combination of them that
+// leaves row i with gcd(a,b) and row j with zero where a and b are the values being combined
+func combineRows(h, u [][]*big.Int, i, j uint, a, b *big.Int) {
+	x, y := new(big.Int), new(big.Int)
+	g := new(big.Int).GCD(x, y, a, b)
+	ag := new(big.Int).Quo(a, g)
+	bg := new(big.Int).Quo(b, g)
+	//[x y; -b/g a/g] has determinant 1
+	for _, t := range [][][]*big.Int{h, u} {
+		for k := range t[i] {
+			ti, tj := t[i][k], t[j][k]
+			t[i][k] = new(big.Int).Add(new(big.Int).Mul(x, ti), new(big.Int).Mul(y, tj))
+			t[j][k] = new(big.Int).Sub(new(big.Int).Mul(ag, tj), new(big.Int).Mul(bg, ti))
+		}
+	}
+}
+
+//scaleRow multiplies every value in row by s
+func scaleRow(row []*big.Int, s *big.Int) {
+	for k := range row {
+		row[k] = new(big.Int).Mul(row[k], s)
+	}
+}
+
+//subRow subtracts q times other from row
+func subRow(row, other []*big.Int, q *big.Int) {
+	if q.Sign() == 0 {
+		return
+	}
+	for k := range row {
+		row[k] = new(big.Int).Sub(row[k], new(big.Int).Mul(q, other[k]))
+	}
+}
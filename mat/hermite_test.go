@@ -0,0 +1,54 @@
+package mat
+
+import (
+	"github.com/nathanhack/lde/vec"
+	"strconv"
+	"testing"
+)
+
+func TestHermiteNormalForm(t *testing.T) {
+	tests := []struct {
+		m, expected *Mat
+	}{
+		{NewMatRows(vec.NewVecInt64(2, 4), vec.NewVecInt64(3, 5)), NewMatRows(vec.NewVecInt64(1, 1), vec.NewVecInt64(0, 2))},
+		{NewMatRows(vec.NewVecInt64(0, 3, 6), vec.NewVecInt64(0, -2, 4)), NewMatRows(vec.NewVecInt64(0, 1, 10), vec.NewVecInt64(0, 0, 24))},
+		{NewMatRows(vec.NewVecInt64(2, 4, 6), vec.NewVecInt64(1, 2, 3), vec.NewVecInt64(-3, 0, 1)), NewMatRows(vec.NewVecInt64(1, 2, 3), vec.NewVecInt64(0, 6, 10), vec.NewVecInt64(0, 0, 0))},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			H, U := test.m.HermiteNormalForm()
+			if !H.Equals(test.expected) {
+				t.Errorf("expected %v but found %v", test.expected, H)
+			}
+			if !U.Mul(test.m).Equals(H) {
+				t.Errorf("expected U⋅m = H but found %v⋅%v = %v", U, test.m, U.Mul(test.m))
+			}
+		})
+	}
+}
+
+func TestKernel(t *testing.T) {
+	tests := []struct {
+		m            *Mat
+		expectedSize int
+	}{
+		{NewMatRows(vec.NewVecInt64(6, -9, 2)), 2},
+		{NewMatRows(vec.NewVecInt64(-1, 1, 2, -3), vec.NewVecInt64(-1, 3, -2, -1)), 2},
+		{NewMatRows(vec.NewVecInt64(2, 4), vec.NewVecInt64(3, 5)), 0},
+		{NewMat(0, 3), 3},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			kernel := test.m.Kernel()
+			if len(kernel) != test.expectedSize {
+				t.Fatalf("expected %v kernel vectors but found %v", test.expectedSize, kernel)
+			}
+			rows, _ := test.m.Shape()
+			for _, k := range kernel {
+				if !test.m.Mul(NewMatCols(k)).GetCol(0).Equals(vec.Zeros(rows)) {
+					t.Errorf("expected %v to be in the kernel of %v", k, test.m)
+				}
+			}
+		})
+	}
+}
@@ -14,15 +14,27 @@ type Mat struct {
 	m    []*big.Int // [rows * col] row based
 }
 
+//NewMat creates a rows x cols matrix from the row based values v, it panics when there are not
+// rows*cols values. See NewMatChecked for a version that returns an error.
 func NewMat(rows, cols uint, v ...*big.Int) *Mat {
+	m, err := NewMatChecked(rows, cols, v...)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+//NewMatChecked creates a rows x cols matrix from the row based values v, a *ShapeError is returned
+// when there are not rows*cols values
+func NewMatChecked(rows, cols uint, v ...*big.Int) (*Mat, error) {
 	if rows*cols != uint(len(v)) {
-		panic("shape mismatch")
+		return nil, &ShapeError{Op: "NewMat", Detail: fmt.Sprintf("%v x %v needs %v values but found %v", rows, cols, rows*cols, len(v))}
 	}
 	return &Mat{
 		rows: rows,
 		cols: cols,
 		m:    v,
-	}
+	}, nil
 }
 
 func NewMatRows(vectors ...*vec.Vec) *Mat {
@@ -36,6 +48,15 @@ func NewMatRows(vectors ...*vec.Vec) *Mat {
 	return m
 }
 
+//NewMatRowsChecked creates a matrix from the rows like NewMatRows but returns a *ShapeError when the
+// rows do not all have the same length instead of padding the short ones with zeros
+func NewMatRowsChecked(vectors ...*vec.Vec) (*Mat, error) {
+	if err := sameLen("NewMatRows", "row", vectors); err != nil {
+		return nil, err
+	}
+	return NewMatRows(vectors...), nil
+}
+
 func NewMatCols(vectors ...*vec.Vec) *Mat {
 	m := &Mat{}
 
@@ -48,6 +69,25 @@ func NewMatCols(vectors ...*vec.Vec) *Mat {
 	return m
 }
 
+//NewMatColsChecked creates a matrix from the columns like NewMatCols but returns a *ShapeError when
+// the columns do not all have the same length instead of padding the short ones with zeros
+func NewMatColsChecked(vectors ...*vec.Vec) (*Mat, error) {
+	if err := sameLen("NewMatCols", "column", vectors); err != nil {
+		return nil, err
+	}
+	return NewMatCols(vectors...), nil
+}
+
+//sameLen returns a *ShapeError for op when the vectors do not all have the same length
+func sameLen(op, name string, vectors []*vec.Vec) error {
+	for i, v := range vectors {
+		if v.Len() != vectors[0].Len() {
+			return &ShapeError{Op: op, Detail: fmt.Sprintf("%v %v has %v values but %v 0 has %v", name, i, v.Len(), name, vectors[0].Len())}
+		}
+	}
+	return nil
+}
+
 //Set returns a copy of this Matrix with the element changed. row and col are zero indexed
 func (m *Mat) Set(row, col uint, value *big.Int) *Mat {
 	rows := internal.Max(m.rows, row+1)
@@ -122,9 +162,20 @@ func (m Mat) String() string {
 	return sb.String()
 }
 
+//Add returns the element wise sum, it panics when the shapes differ. See AddChecked for a version
+// that returns an error.
 func (m *Mat) Add(mat *Mat) *Mat {
+	t, err := m.AddChecked(mat)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+//AddChecked returns the element wise sum or a *ShapeError when the shapes differ
+func (m *Mat) AddChecked(mat *Mat) (*Mat, error) {
 	if m.cols != mat.cols || m.rows != mat.rows {
-		panic("mat's must have the same shape")
+		return nil, &ShapeError{Op: "Add", Detail: fmt.Sprintf("%v x %v != %v x %v", m.rows, m.cols, mat.rows, mat.cols)}
 	}
 
 	t := make([]*big.Int, len(m.m))
@@ -137,7 +188,7 @@ func (m *Mat) Add(mat *Mat) *Mat {
 		rows: m.rows,
 		cols: m.cols,
 		m:    t,
-	}
+	}, nil
 }
 
 func (m *Mat) GetCol(c uint) *vec.Vec {
@@ -163,7 +214,7 @@ func (m *Mat) GetCols() []*vec.Vec {
 }
 
 func (m *Mat) GetRow(r uint) *vec.Vec {
-	if r >= m.cols {
+	if r >= m.rows {
 		return vec.Zeros(m.cols)
 	}
 
@@ -184,9 +235,20 @@ func (m *Mat) GetRows() []*vec.Vec {
 	return toReturn
 }
 
+//Mul returns the matrix product, it panics when the columns of m do not match the rows of mat. See
+// MulChecked for a version that returns an error.
 func (m *Mat) Mul(mat *Mat) *Mat {
+	t, err := m.MulChecked(mat)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+//MulChecked returns the matrix product or a *ShapeError when the columns of m do not match the rows of mat
+func (m *Mat) MulChecked(mat *Mat) (*Mat, error) {
 	if m.cols != mat.rows {
-		panic(fmt.Sprintf("shape mismatch %v x %v != %v x %v", m.rows, m.cols, mat.rows, mat.cols))
+		return nil, &ShapeError{Op: "Mul", Detail: fmt.Sprintf("%v x %v != %v x %v", m.rows, m.cols, mat.rows, mat.cols)}
 	}
 	t := &Mat{}
 	for r := uint(0); r < m.rows; r++ {
@@ -196,5 +258,5 @@ func (m *Mat) Mul(mat *Mat) *Mat {
 			t = t.Set(r, c, rvec.Dot(cvec))
 		}
 	}
-	return t
+	return t, nil
 }
@@ -1,6 +1,7 @@
 package mat
 
 import (
+	"errors"
 	"github.com/nathanhack/lde/vec"
 	"math/big"
 	"strconv"
@@ -32,3 +33,43 @@ func TestGeneral(t *testing.T) {
 		})
 	}
 }
+
+func TestMat_GetRow(t *testing.T) {
+	m := NewMatRows(vec.NewVecInt64(1, 2), vec.NewVecInt64(3, 4), vec.NewVecInt64(5, 6))
+	expected := vec.NewVecInt64(5, 6)
+	if !m.GetRow(2).Equals(expected) {
+		t.Errorf("expected %v but found %v", expected, m.GetRow(2))
+	}
+}
+
+func TestChecked(t *testing.T) {
+	a := NewMatRows(vec.NewVecInt64(1, 2), vec.NewVecInt64(3, 4))
+	b := NewMatRows(vec.NewVecInt64(1, 2, 3))
+	tests := []func() (*Mat, error){
+		func() (*Mat, error) { return NewMatChecked(2, 2, big.NewInt(1)) },
+		func() (*Mat, error) { return NewMatRowsChecked(vec.NewVecInt64(1, 2), vec.NewVecInt64(3)) },
+		func() (*Mat, error) { return NewMatColsChecked(vec.NewVecInt64(1), vec.NewVecInt64(2, 3)) },
+		func() (*Mat, error) { return a.AddChecked(b) },
+		func() (*Mat, error) { return a.MulChecked(b) },
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			m, err := test()
+			var shape *ShapeError
+			if !errors.As(err, &shape) {
+				t.Fatalf("expected a *ShapeError but found %v", err)
+			}
+			if m != nil {
+				t.Errorf("expected no matrix but found %v", m)
+			}
+		})
+	}
+
+	m, err := b.T().MulChecked(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m.Equals(b.T().Mul(b)) {
+		t.Errorf("expected %v but found %v", b.T().Mul(b), m)
+	}
+}
@@ -0,0 +1,145 @@
+package mat
+
+import (
+	"github.com/nathanhack/lde/internal"
+	"math/big"
+)
+
+//SmithNormalForm returns the Smith normal form D of the matrix together with the unimodular matrices
+// U and V such that D = U⋅m⋅V. D is diagonal, the diagonal values d𝑖 are non-negative and each divides
+// the next. The non-zero d𝑖 are the invariant factors of m.
+func (m *Mat) SmithNormalForm() (D, U, V *Mat) {
+	d := m.table()
+	u := Identity(m.rows).table()
+	v := Identity(m.cols).transposed()
+
+	for t := uint(0); t < internal.Min(m.rows, m.cols); t++ {
+		for {
+			//the smallest value left makes the best pivot as it keeps the numbers from growing
+			r, c, found := smallest(d, t)
+			if !found {
+				//everything left is zero
+				return fromTable(d, m.rows, m.cols), fromTable(u, m.rows, m.rows), fromTable(v, m.cols, m.cols).T().padded(m.cols, m.cols)
+			}
+			d[t], d[r] = d[r], d[t]
+			u[t], u[r] = u[r], u[t]
+			swapCols(d, t, c)
+			v[t], v[c] = v[c], v[t]
+
+			//clear the column below the pivot then the row to its right
+			for i := t + 1; i < m.rows; i++ {
+				eliminate(d, u, t, i, d[t][t], d[i][t])
+			}
+			dt := transpose(d)
+			for j := t + 1; j < m.cols; j++ {
+				eliminate(dt, v, t, j, dt[t][t], dt[j][t])
+			}
+			d = transpose(dt)
+
+			if !isolated(d, t) {
+				//clearing the row put values back in the column
+				continue
+			}
+
+			//the pivot must divide everything left, if not we pull the offending row in and go again
+			if i, found := notDivisible(d, t); found {
+				for k := range d[t] {
+					d[t][k] = new(big.Int).Add(d[t][k], d[i][k])
+				}
+				for k := range u[t] {
+					u[t][k] = new(big.Int).Add(u[t][k], u[i][k])
+				}
+				continue
+			}
+			break
+		}
+		if d[t][t].Sign() < 0 {
+			scaleRow(d[t], internal.NegOne)
+			scaleRow(u[t], internal.NegOne)
+		}
+	}
+	return fromTable(d, m.rows, m.cols), fromTable(u, m.rows, m.rows), fromTable(v, m.cols, m.cols).T().padded(m.cols, m.cols)
+}
+
+//transposed returns a copy of the values as columns
+func (m *Mat) transposed() [][]*big.Int {
+	return transpose(m.table())
+}
+
+//transpose returns the table with rows and columns swapped
+func transpose(t [][]*big.Int) [][]*big.Int {
+	if len(t) == 0 {
+		return t
+	}
+	tt := make([][]*big.Int, len(t[0]))
+	for c := range tt {
+		tt[c] = make([]*big.Int, len(t))
+		for r := range t {
+			tt[c][r] = t[r][c]
+		}
+	}
+	return tt
+}
+
+//swapCols swaps columns i and j of t
+func swapCols(t [][]*big.Int, i, j uint) {
+	for r := range t {
+		t[r][i], t[r][j] = t[r][j], t[r][i]
+	}
+}
+
+//eliminate zeros the value b in row j using row i, whose value is a, applying the same row
+// operations to u. When a divides b a plain subtraction is used which grows the numbers the least.
+func eliminate(d, u [][]*big.Int, i, j uint, a, b *big.Int) {
+	if b.Sign() == 0 {
+		return
+	}
+	if new(big.Int).Rem(b, a).Sign() == 0 {
+		q := new(big.Int).Quo(b, a)
+		subRow(d[j], d[i], q)
+		subRow(u[j], u[i], q)
+		return
+	}
+	combineRows(d, u, i, j, a, b)
+}
+
+//smallest finds the non-zero value with the smallest magnitude in the lower right corner starting at (t,t)
+func smallest(d [][]*big.Int, t uint) (row, col uint, found bool) {
+	var best *big.Int
+	for r := t; r < uint(len(d)); r++ {
+		for c := t; c < uint(len(d[r])); c++ {
+			if d[r][c].Sign() != 0 && (best == nil || new(big.Int).Abs(d[r][c]).Cmp(best) < 0) {
+				best = new(big.Int).Abs(d[r][c])
+				row, col, found = r, c, true
+			}
+		}
+	}
+	return
+}
+
+//isolated reports if row and column t are zero apart from the pivot
+func isolated(d [][]*big.Int, t uint) bool {
+	for r := t + 1; r < uint(len(d)); r++ {
+		if d[r][t].Sign() != 0 {
+			return false
+		}
+	}
+	for c := t + 1; c < uint(len(d[t])); c++ {
+		if d[t][c].Sign() != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+//notDivisible finds a row below t holding a value the pivot at (t,t) does not divide
+func notDivisible(d [][]*big.Int, t uint) (row uint, found bool) {
+	for r := t + 1; r < uint(len(d)); r++ {
+		for c := t + 1; c < uint(len(d[r])); c++ {
+			if new(big.Int).Rem(d[r][c], d[t][t]).Sign() != 0 {
+				return r, true
+			}
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,41 @@
+package mat
+
+import (
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+	"strconv"
+	"testing"
+)
+
+func TestSmithNormalForm(t *testing.T) {
+	tests := []struct {
+		m        *Mat
+		expected []int64
+	}{
+		{NewMatRows(vec.NewVecInt64(2, 4, 4), vec.NewVecInt64(-6, 6, 12), vec.NewVecInt64(10, -4, -16)), []int64{2, 6, 12}},
+		{NewMatRows(vec.NewVecInt64(2, 4), vec.NewVecInt64(3, 5)), []int64{1, 2}},
+		{NewMatRows(vec.NewVecInt64(6, -9, 2)), []int64{1}},
+		{NewMatRows(vec.NewVecInt64(4, 6), vec.NewVecInt64(2, 3), vec.NewVecInt64(0, 0)), []int64{1, 0}},
+		{NewMatRows(vec.NewVecInt64(0, 0), vec.NewVecInt64(0, 0)), []int64{0, 0}},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			D, U, V := test.m.SmithNormalForm()
+			if !U.Mul(test.m).Mul(V).Equals(D) {
+				t.Errorf("expected U⋅m⋅V = D but found %v⋅%v⋅%v = %v", U, test.m, V, U.Mul(test.m).Mul(V))
+			}
+			rows, cols := D.Shape()
+			for r := uint(0); r < rows; r++ {
+				for c := uint(0); c < cols; c++ {
+					expected := big.NewInt(0)
+					if r == c {
+						expected = big.NewInt(test.expected[r])
+					}
+					if D.Get(r, c).Cmp(expected) != 0 {
+						t.Errorf("expected %v at (%v,%v) but found %v", expected, r, c, D)
+					}
+				}
+			}
+		})
+	}
+}
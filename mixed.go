@@ -0,0 +1,160 @@
+package lde
+
+import (
+	"context"
+	"fmt"
+	"github.com/nathanhack/lde/internal"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"sort"
+)
+
+//Domain is the set of values a variable ranges over
+type Domain int
+
+const (
+	//Natural variables are 0, 1, 2, ...
+	Natural Domain = iota
+	//Free variables range over all of ℤ
+	Free
+)
+
+func (d Domain) String() string {
+	switch d {
+	case Natural:
+		return "ℕ"
+	case Free:
+		return "ℤ"
+	}
+	return fmt.Sprintf("Domain(%d)", int(d))
+}
+
+//Mixed solves A𝑥 = b where variable 𝑖 ranges over domains[𝑖]. It returns the minimal specific solutions
+// (M1) and the generators (M0) in the original variables, every solution is one from M1 plus any
+// number from M0. Each free 𝑥𝑖 is solved for as 𝑥𝑖⁺ - 𝑥𝑖⁻ and the limits index these split columns, not
+// 𝑥: column 𝑖 holds 𝑥𝑖⁺ for a free 𝑥𝑖, and the 𝑥𝑖⁻ follow the cols of A in the order of the free
+// variables. So a bound on column 𝑖 does not cover 𝑥𝑖⁻, which needs its own. The limits can not see 𝑥
+// itself as 𝑥𝑖 shrinks again when 𝑥𝑖⁻ grows, so a stopped vector could lead back inside them.
+func Mixed(A *mat.Mat, domains []Domain, b *vec.Vec, limits ...LimitBy) (M1 []*vec.Vec, M0 []*vec.Vec) {
+	M1, M0, err := (&Solver{Limits: limits}).Mixed(context.Background(), A, domains, b)
+	mustBeValid(err)
+	return M1, M0
+}
+
+//Mixed solves A𝑥 = b where variable 𝑖 ranges over domains[𝑖], see the package level Mixed.
+func (s *Solver) Mixed(ctx context.Context, A *mat.Mat, domains []Domain, b *vec.Vec) (M1 []*vec.Vec, M0 []*vec.Vec, err error) {
+	if err := validateB(A, b); err != nil {
+		return make([]*vec.Vec, 0), make([]*vec.Vec, 0), err
+	}
+	_, cols := A.Shape()
+	if uint(len(domains)) != cols {
+		return make([]*vec.Vec, 0), make([]*vec.Vec, 0), &LengthError{Arg: "domains", Expected: cols, Found: uint(len(domains))}
+	}
+
+	//each free 𝑥𝑖 becomes 𝑥𝑖⁺ - 𝑥𝑖⁻ with the column for 𝑥𝑖⁻ appended to the end
+	c := A.GetCols()
+	free := make([]uint, 0)
+	for i, d := range domains {
+		switch d {
+		case Natural:
+		case Free:
+			free = append(free, uint(i))
+			c = append(c, A.GetCol(uint(i)).Scalar(internal.NegOne))
+		default:
+			return make([]*vec.Vec, 0), make([]*vec.Vec, 0), &InputError{Arg: "domains", Detail: fmt.Sprintf("unknown domain %v for variable %v", d, i)}
+		}
+	}
+	split := mat.NewMatCols(c...)
+
+	//the limits index the split columns, see the package level Mixed
+	splitted := *s
+	splitted.Checkpoint = nil
+
+	M1, M0, err = splitted.NonHomogeneous(ctx, split, b)
+
+	join := func(vs []*vec.Vec) []*vec.Vec {
+		joined := make([]*vec.Vec, 0, len(vs))
+		for _, v := range vs {
+			x := v.Slice(0, cols)
+			for k, i := range free {
+				x = x.Set(i, x.Get(i).Sub(x.Get(i), v.Get(cols+uint(k))))
+			}
+			joined = append(joined, x)
+		}
+		return distinct(joined)
+	}
+
+	inCone := func(d *vec.Vec) bool {
+		for i, domain := range domains {
+			if domain == Natural && d.Get(uint(i)).Sign() < 0 {
+				return false
+			}
+		}
+		return a(A, d).Equals(vec.Zeros(0))
+	}
+	M1 = minimal(join(M1), inCone)
+
+	//every 𝑥𝑖⁺ + 𝑥𝑖⁻ pair joins to zero which adds nothing
+	generators := make([]*vec.Vec, 0)
+	for _, g := range join(M0) {
+		if !g.Equals(vec.Zeros(0)) {
+			generators = append(generators, g)
+		}
+	}
+	if err != nil {
+		return s.order(M1), s.order(generators), err
+	}
+	generators, err = s.irredundant(ctx, generators)
+	return s.order(M1), s.order(generators), err
+}
+
+//irredundant drops each generator that is a sum of the other generators kept, trying the largest first
+// so the small ones stay. With free variables the cone can hold both g and -g, which minimal can not cope
+// with, so instead each generator is checked by solving for natural coefficients that make it out of
+// the rest. If ctx is done first the generators are returned as they are with an *IncompleteError.
+func (s *Solver) irredundant(ctx context.Context, generators []*vec.Vec) ([]*vec.Vec, error) {
+	largest := append([]*vec.Vec{}, generators...)
+	sort.SliceStable(largest, func(i, j int) bool {
+		return largest[i].Dot(largest[i]).Cmp(largest[j].Dot(largest[j])) > 0
+	})
+
+	kept := make(map[string]bool)
+	for _, g := range largest {
+		kept[g.String()] = true
+	}
+	member := &Solver{Workers: s.Workers}
+	for _, g := range largest {
+		others := make([]*vec.Vec, 0, len(kept))
+		for _, h := range largest {
+			if h != g && kept[h.String()] {
+				others = append(others, h)
+			}
+		}
+		if len(others) == 0 {
+			continue
+		}
+
+		//g is a sum of the others when Hc = g has a natural solution c, the columns of H being the others
+		sum := false
+		err := member.NonHomogeneousFunc(ctx, mat.NewMatCols(others...), g, func(c *vec.Vec, specific bool) bool {
+			sum = specific
+			return !specific
+		})
+		switch err.(type) {
+		case nil, *InfeasibleError:
+		default:
+			return generators, err
+		}
+		if sum {
+			kept[g.String()] = false
+		}
+	}
+
+	irredundant := make([]*vec.Vec, 0, len(generators))
+	for _, g := range generators {
+		if kept[g.String()] {
+			irredundant = append(irredundant, g)
+		}
+	}
+	return irredundant, nil
+}
@@ -0,0 +1,91 @@
+package lde
+
+import (
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"strconv"
+	"testing"
+)
+
+func TestMixed(t *testing.T) {
+	tests := []struct {
+		a          *mat.Mat
+		domains    []Domain
+		b          *vec.Vec
+		expectedM1 []*vec.Vec
+		expectedM0 []*vec.Vec
+	}{
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, 1)),
+			[]Domain{Free, Natural},
+			vec.NewVecInt64(2),
+			[]*vec.Vec{vec.NewVecInt64(2, 0)},
+			[]*vec.Vec{vec.NewVecInt64(-1, 1)},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, -1)),
+			[]Domain{Free, Free},
+			vec.NewVecInt64(0),
+			[]*vec.Vec{},
+			[]*vec.Vec{vec.NewVecInt64(-1, -1), vec.NewVecInt64(1, 1)},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, 1)),
+			[]Domain{Free, Natural},
+			vec.NewVecInt64(-2),
+			[]*vec.Vec{vec.NewVecInt64(-2, 0)},
+			[]*vec.Vec{vec.NewVecInt64(-1, 1)},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(3, 9, 5)),
+			[]Domain{Natural, Natural, Natural},
+			vec.NewVecInt64(20),
+			[]*vec.Vec{vec.NewVecInt64(0, 0, 4), vec.NewVecInt64(2, 1, 1), vec.NewVecInt64(5, 0, 1)},
+			[]*vec.Vec{},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(2, 4)),
+			[]Domain{Free, Natural},
+			vec.NewVecInt64(6),
+			[]*vec.Vec{vec.NewVecInt64(3, 0)},
+			[]*vec.Vec{vec.NewVecInt64(-2, 1)},
+		},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			actualM1, actualM0 := Mixed(test.a, test.domains, test.b)
+			if !equalVecs(test.expectedM1, actualM1) {
+				t.Errorf("expected %v but found %v", test.expectedM1, actualM1)
+			}
+			if !equalVecs(test.expectedM0, actualM0) {
+				t.Errorf("expected %v but found %v", test.expectedM0, actualM0)
+			}
+		})
+	}
+}
+
+func TestMixed_Irredundant(t *testing.T) {
+	tests := []struct {
+		a       *mat.Mat
+		domains []Domain
+		b       *vec.Vec
+	}{
+		{mat.NewMatRows(vec.NewVecInt64(2, 3, -1)), []Domain{Free, Natural, Natural}, vec.NewVecInt64(1)},
+		{mat.NewMatRows(vec.NewVecInt64(1, 1, 1)), []Domain{Free, Free, Natural}, vec.NewVecInt64(0)},
+		{mat.NewMatRows(vec.NewVecInt64(1, -2, 3), vec.NewVecInt64(0, 1, 1)), []Domain{Free, Natural, Free}, vec.NewVecInt64(2, 1)},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			_, M0 := Mixed(test.a, test.domains, test.b)
+			for j, g := range M0 {
+				others := append(append([]*vec.Vec{}, M0[:j]...), M0[j+1:]...)
+				if len(others) == 0 {
+					continue
+				}
+				if M1, _ := NonHomogeneous(mat.NewMatCols(others...), g); len(M1) > 0 {
+					t.Errorf("expected %v not to be a sum of %v but found %v", g, others, M1[0])
+				}
+			}
+		})
+	}
+}
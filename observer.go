@@ -0,0 +1,86 @@
+package lde
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+//LevelStats describes the work done in one level of the search
+type LevelStats struct {
+	//Level counts up from 1 with the size of the vectors in the frontier
+	Level int
+	//Frontier is the number of vectors in 𝓟 at the start of the level
+	Frontier int
+	//Solutions is the number of new minimal bases added to 𝓑
+	Solutions int
+	//Contained is the number of vectors dropped for containing a basis already in 𝓑
+	Contained int
+	//Pruned is the number of expansions a LimitBy stopped
+	Pruned int
+	//Duration is the time spent on the level
+	Duration time.Duration
+}
+
+//Observer is told about each level of the search as it finishes. It is called from the
+// goroutine running the solve, never concurrently.
+type Observer interface {
+	Level(stats LevelStats)
+}
+
+//observe passes the stats for a level to the solver's observer, if any
+func (s *Solver) observe(stats LevelStats, start time.Time) {
+	if s.Observer == nil {
+		return
+	}
+	stats.Duration = time.Since(start)
+	s.Observer.Level(stats)
+}
+
+type slogObserver struct {
+	logger *slog.Logger
+	level  slog.Level
+}
+
+//NewSlogObserver returns an Observer that writes a record for each level to logger at the given level
+func NewSlogObserver(logger *slog.Logger, level slog.Level) Observer {
+	return &slogObserver{logger: logger, level: level}
+}
+
+func (o *slogObserver) Level(stats LevelStats) {
+	o.logger.LogAttrs(context.Background(), o.level, "lde level",
+		slog.Int("level", stats.Level),
+		slog.Int("frontier", stats.Frontier),
+		slog.Int("solutions", stats.Solutions),
+		slog.Int("contained", stats.Contained),
+		slog.Int("pruned", stats.Pruned),
+		slog.Duration("duration", stats.Duration),
+	)
+}
+
+//Summary is an Observer that collects the totals of a solve. It is not safe to share between
+// solves running at the same time.
+type Summary struct {
+	Levels      int
+	MaxFrontier int
+	Frontier    int
+	Solutions   int
+	Contained   int
+	Pruned      int
+	Duration    time.Duration
+	//PerLevel holds the stats of every level in order
+	PerLevel []LevelStats
+}
+
+func (s *Summary) Level(stats LevelStats) {
+	s.Levels++
+	if stats.Frontier > s.MaxFrontier {
+		s.MaxFrontier = stats.Frontier
+	}
+	s.Frontier += stats.Frontier
+	s.Solutions += stats.Solutions
+	s.Contained += stats.Contained
+	s.Pruned += stats.Pruned
+	s.Duration += stats.Duration
+	s.PerLevel = append(s.PerLevel, stats)
+}
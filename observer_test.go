@@ -0,0 +1,53 @@
+package lde
+
+import (
+	"bytes"
+	"context"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"log/slog"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestSummary(t *testing.T) {
+	A := mat.NewMatRows(vec.NewVecInt64(6, -9, 2))
+	summary := &Summary{}
+	s := &Solver{Observer: summary, Limits: []LimitBy{NewMaxXLimit(big.NewInt(4))}}
+
+	bases, err := s.Homogeneous(context.Background(), A)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.Solutions != len(bases) {
+		t.Errorf("expected %v solutions but found %v", len(bases), summary.Solutions)
+	}
+	if summary.Levels != len(summary.PerLevel) || summary.Levels == 0 {
+		t.Errorf("expected a level count matching %v but found %v", len(summary.PerLevel), summary.Levels)
+	}
+	if summary.PerLevel[0].Frontier != 3 {
+		t.Errorf("expected the first level to start with 3 vectors but found %v", summary.PerLevel[0].Frontier)
+	}
+	if summary.Pruned == 0 {
+		t.Errorf("expected the limit to prune some expansions")
+	}
+	for i, stats := range summary.PerLevel {
+		if stats.Level != i+1 {
+			t.Errorf("expected level %v but found %v", i+1, stats.Level)
+		}
+	}
+}
+
+func TestNewSlogObserver(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := slog.New(slog.NewTextHandler(buf, nil))
+	s := &Solver{Observer: NewSlogObserver(logger, slog.LevelInfo)}
+
+	if _, err := s.Homogeneous(context.Background(), mat.NewMatRows(vec.NewVecInt64(1, -1))); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "level=1") || !strings.Contains(buf.String(), "solutions=1") {
+		t.Errorf("expected level records but found %v", buf.String())
+	}
+}
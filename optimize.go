@@ -0,0 +1,141 @@
+package lde
+
+import (
+	"context"
+	"fmt"
+	"github.com/nathanhack/lde/internal"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+)
+
+//Sense is the direction of an optimization
+type Sense int
+
+const (
+	Minimize Sense = iota
+	Maximize
+)
+
+func (s Sense) String() string {
+	switch s {
+	case Minimize:
+		return "minimize"
+	case Maximize:
+		return "maximize"
+	}
+	return fmt.Sprintf("Sense(%d)", int(s))
+}
+
+//Optimum is the result of Optimize
+type Optimum struct {
+	//Value is c⋅X, it is nil when Unbounded
+	Value *big.Int
+	//X is an optimal solution, or when Unbounded the solution that Direction improves on
+	X *vec.Vec
+	//Unbounded reports there is no optimum as adding Direction to X again and again keeps improving c⋅𝑥
+	Unbounded bool
+	Direction *vec.Vec
+}
+
+//Optimize finds the natural solution of A𝑥 = b inside bounds that minimizes or maximizes c⋅𝑥. It uses the
+// structure of the solutions: when no member of M0 improves c⋅𝑥 the optimum is among M1, otherwise a
+// member of M0 that improves it without touching a bounded variable makes the problem unbounded, and
+// failing that the bounded solutions are searched. An *InfeasibleError or ErrNoSolution is returned
+// when there is no solution.
+func Optimize(A *mat.Mat, b *vec.Vec, c *vec.Vec, sense Sense, bounds Bounds, limits ...LimitBy) (*Optimum, error) {
+	return (&Solver{Limits: limits}).Optimize(context.Background(), A, b, c, sense, bounds)
+}
+
+//Optimize finds the natural solution of A𝑥 = b inside bounds that minimizes or maximizes c⋅𝑥, see the
+// package level Optimize.
+func (s *Solver) Optimize(ctx context.Context, A *mat.Mat, b *vec.Vec, c *vec.Vec, sense Sense, bounds Bounds) (*Optimum, error) {
+	if err := validateB(A, b); err != nil {
+		return nil, err
+	}
+	_, cols := A.Shape()
+	if c == nil || c.Len() != cols {
+		found := uint(0)
+		if c != nil {
+			found = c.Len()
+		}
+		return nil, &LengthError{Arg: "c", Expected: cols, Found: found}
+	}
+
+	//maximizing c⋅𝑥 is minimizing -c⋅𝑥
+	cost := c
+	if sense == Maximize {
+		cost = c.Scalar(internal.NegOne)
+	}
+
+	M1, M0, err := s.NonHomogeneousBounded(ctx, A, b, bounds)
+	if err != nil {
+		return nil, err
+	}
+	//zero stands in for an empty M1 when b is zero, it is still checked against the lower bounds
+	M1 = specifics(A, b, M1)
+
+	var best *vec.Vec
+	var bestCost *big.Int
+	consider := func(x *vec.Vec) bool {
+		if !inBounds(x, bounds) {
+			return true
+		}
+		xc := cost.Dot(x)
+		if best == nil || xc.Cmp(bestCost) < 0 || xc.Cmp(bestCost) == 0 && x.Cmp(best) < 0 {
+			best, bestCost = x, xc
+		}
+		return true
+	}
+	for _, m1 := range M1 {
+		consider(m1)
+	}
+	if best == nil {
+		return nil, ErrNoSolution
+	}
+
+	//generators that do not improve c⋅𝑥 never help, the rest either make it unbounded or must be added
+	improving := make([]*vec.Vec, 0)
+	for _, g := range M0 {
+		if cost.Dot(g).Sign() >= 0 {
+			continue
+		}
+		if !touchesBound(g, bounds) {
+			return &Optimum{X: best, Unbounded: true, Direction: g}, nil
+		}
+		improving = append(improving, g)
+	}
+
+	if len(improving) > 0 {
+		//dropping a generator that does not improve keeps a solution inside the bounds, so only the
+		// improving ones need adding and as they all touch a bounded variable there are only so many. The
+		// solver's limits were kept by NonHomogeneousBounded so they must keep holding here.
+		limits := append(append([]LimitBy{}, s.Limits...), NewBoundsLimit(bounds))
+		if err := Enumerate(ctx, A, b, M1, improving, consider, limits...); err != nil {
+			return nil, err
+		}
+	}
+
+	value := c.Dot(best)
+	return &Optimum{Value: value, X: best}, nil
+}
+
+//touchesBound reports if g has a non-zero value for a variable with an upper bound
+func touchesBound(g *vec.Vec, bounds Bounds) bool {
+	for i, bound := range bounds {
+		if bound.Hi != nil && g.Get(i).Sign() != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+//inBounds reports if every value of x is inside its variable's Bound
+func inBounds(x *vec.Vec, bounds Bounds) bool {
+	for i, bound := range bounds {
+		if bound.Lo != nil && x.Get(i).Cmp(bound.Lo) < 0 || bound.Hi != nil && x.Get(i).Cmp(bound.Hi) > 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,142 @@
+package lde
+
+import (
+	"context"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestOptimize(t *testing.T) {
+	tests := []struct {
+		a, b, c           *vec.Vec
+		sense             Sense
+		bounds            Bounds
+		expectedValue     int64
+		expectedX         *vec.Vec
+		expectedUnbounded bool
+	}{
+		{vec.NewVecInt64(1, -1), vec.NewVecInt64(2), vec.NewVecInt64(1, 1), Minimize, nil, 2, vec.NewVecInt64(2, 0), false},
+		{vec.NewVecInt64(1, -1), vec.NewVecInt64(2), vec.NewVecInt64(1, 1), Maximize, nil, 0, vec.NewVecInt64(2, 0), true},
+		{vec.NewVecInt64(1, -1), vec.NewVecInt64(2), vec.NewVecInt64(1, 1), Maximize, Bounds{0: {Hi: big.NewInt(5)}}, 8, vec.NewVecInt64(5, 3), false},
+		{vec.NewVecInt64(3, 9, 5), vec.NewVecInt64(20), vec.NewVecInt64(1, -2, 0), Minimize, nil, 0, vec.NewVecInt64(0, 0, 4), false},
+		{vec.NewVecInt64(3, 9, 5), vec.NewVecInt64(20), vec.NewVecInt64(1, 1, 1), Maximize, nil, 6, vec.NewVecInt64(5, 0, 1), false},
+		{vec.NewVecInt64(6, -9, 2), vec.NewVecInt64(0), vec.NewVecInt64(1, 1, -1), Minimize, Bounds{1: {Hi: big.NewInt(4)}}, -14, vec.NewVecInt64(0, 4, 18), false},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			optimum, err := Optimize(mat.NewMatRows(test.a), test.b, test.c, test.sense, test.bounds)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if optimum.Unbounded != test.expectedUnbounded {
+				t.Fatalf("expected unbounded %v but found %v", test.expectedUnbounded, optimum)
+			}
+			if optimum.Unbounded {
+				if test.c.Dot(optimum.Direction).Sign() <= 0 {
+					t.Errorf("expected %v to improve %v", optimum.Direction, test.c)
+				}
+				return
+			}
+			if optimum.Value.Cmp(big.NewInt(test.expectedValue)) != 0 || !optimum.X.Equals(test.expectedX) {
+				t.Errorf("expected %v at %v but found %v at %v", test.expectedValue, test.expectedX, optimum.Value, optimum.X)
+			}
+		})
+	}
+}
+
+func TestOptimize_Limits(t *testing.T) {
+	A := mat.NewMatRows(vec.NewVecInt64(1, -1))
+	s := &Solver{Limits: []LimitBy{NewMaxXLimit(big.NewInt(5))}}
+	optimum, err := s.Optimize(context.Background(), A, vec.NewVecInt64(0), vec.NewVecInt64(1, 0), Maximize, Bounds{0: {Hi: big.NewInt(10)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := vec.NewVecInt64(4, 4)
+	if optimum.Value.Cmp(big.NewInt(4)) != 0 || !optimum.X.Equals(expected) {
+		t.Errorf("expected 4 at %v but found %v at %v", expected, optimum.Value, optimum.X)
+	}
+}
+
+func TestOptimize_Infeasible(t *testing.T) {
+	_, err := Optimize(mat.NewMatRows(vec.NewVecInt64(2)), vec.NewVecInt64(1), vec.NewVecInt64(1), Minimize, nil)
+	if _, ok := err.(*InfeasibleError); !ok {
+		t.Errorf("expected an *InfeasibleError but found %v", err)
+	}
+
+	tests := []struct {
+		a, b, c *vec.Vec
+		bounds  Bounds
+	}{
+		{vec.NewVecInt64(1, 1), vec.NewVecInt64(3), vec.NewVecInt64(1, 1), Bounds{0: {Hi: big.NewInt(1)}, 1: {Hi: big.NewInt(1)}}},
+		{vec.NewVecInt64(1, -1), vec.NewVecInt64(0), vec.NewVecInt64(1, 1), Bounds{0: {Lo: big.NewInt(1), Hi: big.NewInt(1)}, 1: {Hi: big.NewInt(0)}}},
+		{vec.NewVecInt64(2, -3, -3), vec.NewVecInt64(0), vec.NewVecInt64(1, 1, 1), Bounds{0: {Lo: big.NewInt(1), Hi: big.NewInt(3)}, 1: {Hi: big.NewInt(0)}, 2: {Lo: big.NewInt(1), Hi: big.NewInt(1)}}},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			optimum, err := Optimize(mat.NewMatRows(test.a), test.b, test.c, Minimize, test.bounds)
+			if err != ErrNoSolution {
+				t.Errorf("expected ErrNoSolution but found %v and %v", optimum, err)
+			}
+		})
+	}
+}
+
+func TestOptimize_BruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 400; n++ {
+		a := vec.NewVecInt64(r.Int63n(7)-3, r.Int63n(7)-3, r.Int63n(7)-3)
+		b := vec.NewVecInt64(r.Int63n(5) - 2)
+		c := vec.NewVecInt64(r.Int63n(7)-3, r.Int63n(7)-3, r.Int63n(7)-3)
+		bounds := Bounds{}
+		lo, hi := make([]int64, 3), make([]int64, 3)
+		for i := range lo {
+			lo[i] = r.Int63n(3)
+			hi[i] = lo[i] + r.Int63n(4) - 1
+			bounds[uint(i)] = Bound{Lo: big.NewInt(lo[i]), Hi: big.NewInt(hi[i])}
+		}
+
+		//every solution in the box with the smallest c⋅𝑥
+		var expected []*vec.Vec
+		var value int64
+		for x := lo[0]; x <= hi[0]; x++ {
+			for y := lo[1]; y <= hi[1]; y++ {
+				for z := lo[2]; z <= hi[2]; z++ {
+					v := vec.NewVecInt64(x, y, z)
+					if a.Dot(v).Cmp(b.Get(0)) != 0 {
+						continue
+					}
+					vc := c.Dot(v).Int64()
+					if expected == nil || vc < value {
+						expected, value = nil, vc
+					}
+					if vc == value {
+						expected = append(expected, v)
+					}
+				}
+			}
+		}
+
+		optimum, err := Optimize(mat.NewMatRows(a), b, c, Minimize, bounds)
+		if expected == nil {
+			if err == nil {
+				t.Errorf("%v⋅𝑥 = %v in %v: expected no solution but found %v", a, b, bounds, optimum.X)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v⋅𝑥 = %v in %v: expected %v but found %v", a, b, bounds, expected, err)
+			continue
+		}
+		found := false
+		for _, x := range expected {
+			found = found || x.Equals(optimum.X)
+		}
+		if !found || optimum.Value.Int64() != value {
+			t.Errorf("%v⋅𝑥 = %v in %v: expected %v at %v but found %v at %v", a, b, bounds, value, expected, optimum.Value, optimum.X)
+		}
+	}
+}
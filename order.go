@@ -0,0 +1,89 @@
+package lde
+
+import (
+	"github.com/nathanhack/lde/internal"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+	"sort"
+)
+
+//Order compares two vectors for sorting results, it returns a negative number when a comes
+// before b, a positive number when b comes before a and zero when they are equal.
+type Order func(a, b *vec.Vec) int
+
+//Lex orders vectors lexicographically, the order used when a Solver has no Order
+func Lex(a, b *vec.Vec) int {
+	return a.Cmp(b)
+}
+
+//GradedLex orders vectors by the sum of their values, breaking ties with Lex
+func GradedLex(a, b *vec.Vec) int {
+	if c := degree(a).Cmp(degree(b)); c != 0 {
+		return c
+	}
+	return a.Cmp(b)
+}
+
+//GradedReverseLex orders vectors by the sum of their values, breaking ties by putting the vector
+// with the larger value at the last index where they differ first
+func GradedReverseLex(a, b *vec.Vec) int {
+	if c := degree(a).Cmp(degree(b)); c != 0 {
+		return c
+	}
+	for i := int(internal.Max(a.Len(), b.Len())) - 1; i >= 0; i-- {
+		if c := a.Get(uint(i)).Cmp(b.Get(uint(i))); c != 0 {
+			return -c
+		}
+	}
+	return 0
+}
+
+//BySupport orders vectors by their number of non-zero values, breaking ties with Lex
+func BySupport(a, b *vec.Vec) int {
+	if c := support(a) - support(b); c != 0 {
+		return c
+	}
+	return a.Cmp(b)
+}
+
+//Weighted orders vectors by w⋅𝑥, breaking ties with Lex
+func Weighted(w *vec.Vec) Order {
+	return func(a, b *vec.Vec) int {
+		if c := w.Dot(a).Cmp(w.Dot(b)); c != 0 {
+			return c
+		}
+		return a.Cmp(b)
+	}
+}
+
+//order sorts vs in place with the solver's Order and returns it
+func (s *Solver) order(vs []*vec.Vec) []*vec.Vec {
+	order := s.Order
+	if order == nil {
+		order = Lex
+	}
+	sort.SliceStable(vs, func(i, j int) bool {
+		return order(vs[i], vs[j]) < 0
+	})
+	return vs
+}
+
+//degree is the sum of the values of v
+func degree(v *vec.Vec) *big.Int {
+	sum := new(big.Int)
+	for i := uint(0); i < v.Len(); i++ {
+		sum.Add(sum, v.Get(i))
+	}
+	return sum
+}
+
+//support is the number of non-zero values of v
+func support(v *vec.Vec) int {
+	n := 0
+	for i := uint(0); i < v.Len(); i++ {
+		if v.Get(i).Sign() != 0 {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,100 @@
+package lde
+
+import (
+	"context"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"strconv"
+	"testing"
+)
+
+func TestOrder(t *testing.T) {
+	vs := func() []*vec.Vec {
+		return []*vec.Vec{
+			vec.NewVecInt64(0, 3, 0),
+			vec.NewVecInt64(1, 1, 0),
+			vec.NewVecInt64(0, 1, 1),
+			vec.NewVecInt64(2, 0, 0),
+			vec.NewVecInt64(1, 1, 1),
+		}
+	}
+	tests := []struct {
+		order    Order
+		expected []*vec.Vec
+	}{
+		{
+			Lex,
+			[]*vec.Vec{vec.NewVecInt64(0, 1, 1), vec.NewVecInt64(0, 3, 0), vec.NewVecInt64(1, 1, 0), vec.NewVecInt64(1, 1, 1), vec.NewVecInt64(2, 0, 0)},
+		},
+		{
+			GradedLex,
+			[]*vec.Vec{vec.NewVecInt64(0, 1, 1), vec.NewVecInt64(1, 1, 0), vec.NewVecInt64(2, 0, 0), vec.NewVecInt64(0, 3, 0), vec.NewVecInt64(1, 1, 1)},
+		},
+		{
+			GradedReverseLex,
+			[]*vec.Vec{vec.NewVecInt64(0, 1, 1), vec.NewVecInt64(1, 1, 0), vec.NewVecInt64(2, 0, 0), vec.NewVecInt64(1, 1, 1), vec.NewVecInt64(0, 3, 0)},
+		},
+		{
+			BySupport,
+			[]*vec.Vec{vec.NewVecInt64(0, 3, 0), vec.NewVecInt64(2, 0, 0), vec.NewVecInt64(0, 1, 1), vec.NewVecInt64(1, 1, 0), vec.NewVecInt64(1, 1, 1)},
+		},
+		{
+			Weighted(vec.NewVecInt64(3, 1, 1)),
+			[]*vec.Vec{vec.NewVecInt64(0, 1, 1), vec.NewVecInt64(0, 3, 0), vec.NewVecInt64(1, 1, 0), vec.NewVecInt64(1, 1, 1), vec.NewVecInt64(2, 0, 0)},
+		},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			actual := (&Solver{Order: test.order}).order(vs())
+			if !equalVecs(test.expected, actual) {
+				t.Errorf("expected %v but found %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGradedReverseLex_Ties(t *testing.T) {
+	a := vec.NewVecInt64(1, 0, 1)
+	b := vec.NewVecInt64(0, 2, 0)
+	if GradedReverseLex(a, b) >= 0 {
+		t.Errorf("expected %v before %v", a, b)
+	}
+	if GradedReverseLex(b, a) <= 0 {
+		t.Errorf("expected %v after %v", b, a)
+	}
+	if GradedReverseLex(a, a) != 0 {
+		t.Errorf("expected %v to equal itself", a)
+	}
+}
+
+func TestSolver_Order(t *testing.T) {
+	A := mat.NewMatRows(vec.NewVecInt64(1, 1, -2, -1))
+	b := vec.NewVecInt64(3)
+
+	s := Solver{Order: GradedLex}
+	M1, M0, err := s.NonHomogeneous(context.Background(), A, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lex1, lex0 := NonHomogeneous(A, b)
+	if !equalVecs(distinct(M1), lex1) || !equalVecs(distinct(M0), lex0) {
+		t.Fatalf("expected the same solutions as NonHomogeneous but found %v %v", M1, M0)
+	}
+	for _, vs := range [][]*vec.Vec{M1, M0} {
+		for i := 1; i < len(vs); i++ {
+			if GradedLex(vs[i-1], vs[i]) > 0 {
+				t.Errorf("expected graded lex order but found %v", vs)
+			}
+		}
+	}
+
+	H, err := s.Homogeneous(context.Background(), A)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i < len(H); i++ {
+		if GradedLex(H[i-1], H[i]) > 0 {
+			t.Errorf("expected graded lex order but found %v", H)
+		}
+	}
+}
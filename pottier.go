@@ -0,0 +1,195 @@
+package lde
+
+import (
+	"container/heap"
+	"context"
+	"github.com/nathanhack/lde/internal"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+)
+
+//Pottier is an Algorithm using Pottier's completion procedure. It works with the pairs (𝑥, A𝑥) for
+// natural 𝑥, starting from (e𝑖, Ae𝑖) and adding the reduced sum of any two members until every sum
+// reduces to zero. The members of the form (𝑥, 0) are then the Hilbert basis. It suits systems with
+// many variables and few equations. Limits only filter the bases found, they can not prune the
+// completion, and when ctx is done no bases are returned as none are known to be minimal until the
+// completion finishes.
+type Pottier struct{}
+
+//Hilbert computes the Hilbert basis of A𝑥 = 0, see Algorithm
+func (Pottier) Hilbert(ctx context.Context, A *mat.Mat, s *Solver) ([]*vec.Vec, error) {
+	rows, cols := A.Shape()
+
+	G := make([]*vec.Vec, 0, cols)
+	for i := uint(0); i < cols; i++ {
+		G = append(G, lift(vec.Eigen(i).Slice(0, cols), A.GetCol(i)))
+	}
+
+	G, err := complete(ctx, G, nil)
+	if err != nil {
+		return make([]*vec.Vec, 0), err
+	}
+
+	//the Hilbert basis is the minimal (𝑥, 0)
+	solutions := make([]*vec.Vec, 0)
+	zeros := vec.Zeros(rows)
+	for _, g := range G {
+		if g.Slice(cols, cols+rows).Equals(zeros) {
+			solutions = append(solutions, g.Slice(0, cols))
+		}
+	}
+	return minimal(solutions, func(d *vec.Vec) bool { return natural(d) }), nil
+}
+
+//lift returns 𝑥 with a𝑥 appended
+func lift(x, ax *vec.Vec) *vec.Vec {
+	n := x.Len()
+	for i := uint(0); i < ax.Len(); i++ {
+		x = x.Set(n+i, ax.Get(i))
+	}
+	return x
+}
+
+//complete adds the reduced sum of any two members of G to it until every such sum reduces to zero. Only
+// the values set in on are looked at, nil means all of them, and the vectors must be told apart by those
+// values alone. Then every vector made by adding up members of G is a sum of members that lie below it
+// in the ⊑ order.
+func complete(ctx context.Context, G []*vec.Vec, on []bool) ([]*vec.Vec, error) {
+	//sums are worked through smallest first so the small members that reduce the rest turn up early,
+	// a sum of two sign compatible members reduces to zero so those are never queued
+	pending := &pairs{}
+	for j := range G {
+		for i := 0; i < j; i++ {
+			pending.add(G, i, j, on)
+		}
+	}
+	for pending.Len() > 0 {
+		if err := ctx.Err(); err != nil {
+			return G, &IncompleteError{Err: err}
+		}
+		p := heap.Pop(pending).(pair)
+		f := normalForm(p.sum, G, on)
+		if f == nil {
+			continue
+		}
+		G = append(G, f)
+		for i := 0; i < len(G)-1; i++ {
+			pending.add(G, i, len(G)-1, on)
+		}
+	}
+	return G, nil
+}
+
+//normalForm takes members of G away from f for as long as one lies below it in the ⊑ order, it returns
+// nil when f reduces to zero
+func normalForm(f *vec.Vec, G []*vec.Vec, on []bool) *vec.Vec {
+reduceLoop:
+	for norm(f, on).Sign() != 0 {
+		for _, g := range G {
+			if conformal(g, f, on) {
+				f = f.Sub(g)
+				continue reduceLoop
+			}
+		}
+		return f
+	}
+	return nil
+}
+
+//conformal is true when g ⊑ f, that is every g𝑖 has the sign of f𝑖 or is zero and |g𝑖| ≤ |f𝑖|
+func conformal(g, f *vec.Vec, on []bool) bool {
+	for i := uint(0); i < internal.Max(g.Len(), f.Len()); i++ {
+		if on != nil && !on[i] {
+			continue
+		}
+		gi, fi := g.Get(i), f.Get(i)
+		if gi.Sign() == 0 {
+			continue
+		}
+		if gi.Sign() != fi.Sign() || gi.CmpAbs(fi) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+//signCompatible is true when no value of f has the opposite sign of the same value of g
+func signCompatible(f, g *vec.Vec, on []bool) bool {
+	for i := uint(0); i < internal.Max(f.Len(), g.Len()); i++ {
+		if on != nil && !on[i] {
+			continue
+		}
+		if f.Get(i).Sign()*g.Get(i).Sign() < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+//norm is the sum of the absolute values of v
+func norm(v *vec.Vec, on []bool) *big.Int {
+	sum := new(big.Int)
+	for i := uint(0); i < v.Len(); i++ {
+		if on != nil && !on[i] {
+			continue
+		}
+		sum.Add(sum, new(big.Int).Abs(v.Get(i)))
+	}
+	return sum
+}
+
+//pair is the sum of two members of G waiting to be reduced
+type pair struct {
+	sum  *vec.Vec
+	norm *big.Int
+}
+
+//pairs is a heap of the pairs with the smallest norm first, ties go to the pair queued first
+type pairs struct {
+	items []pair
+	order []int
+	next  int
+}
+
+//add queues the sum of G[i] and G[j] unless it is sure to reduce to zero
+func (p *pairs) add(G []*vec.Vec, i, j int, on []bool) {
+	if signCompatible(G[i], G[j], on) {
+		return
+	}
+	sum := G[i].Add(G[j])
+	n := norm(sum, on)
+	if n.Sign() == 0 {
+		return
+	}
+	heap.Push(p, pair{sum: sum, norm: n})
+}
+
+func (p *pairs) Len() int {
+	return len(p.items)
+}
+
+func (p *pairs) Less(i, j int) bool {
+	if c := p.items[i].norm.Cmp(p.items[j].norm); c != 0 {
+		return c < 0
+	}
+	return p.order[i] < p.order[j]
+}
+
+func (p *pairs) Swap(i, j int) {
+	p.items[i], p.items[j] = p.items[j], p.items[i]
+	p.order[i], p.order[j] = p.order[j], p.order[i]
+}
+
+func (p *pairs) Push(x interface{}) {
+	p.items = append(p.items, x.(pair))
+	p.order = append(p.order, p.next)
+	p.next++
+}
+
+func (p *pairs) Pop() interface{} {
+	n := len(p.items) - 1
+	x := p.items[n]
+	p.items, p.order = p.items[:n], p.order[:n]
+	return x
+}
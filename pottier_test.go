@@ -0,0 +1,25 @@
+package lde
+
+import (
+	"context"
+	"errors"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"testing"
+)
+
+func TestPottier(t *testing.T) {
+	sameAsContejeanDevie(t, Pottier{})
+}
+
+func TestPottier_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	bases, err := (&Solver{Algorithm: Pottier{}}).Homogeneous(ctx, mat.NewMatRows(vec.NewVecInt64(6, -9, 2)))
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %v but found %v", context.Canceled, err)
+	}
+	if len(bases) != 0 {
+		t.Errorf("expected no bases but found %v", bases)
+	}
+}
@@ -0,0 +1,75 @@
+package lde
+
+import (
+	"context"
+	"github.com/nathanhack/lde/internal"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+)
+
+//ProjectAndLift is an Algorithm in the style of 4ti2. It works in the integer kernel of A, first on the
+// few coordinates the kernel is fixed by and then lifting one coordinate at a time. Each step completes
+// the vectors under the ⊑ order of the coordinates seen so far and drops those gone negative, so at the
+// end the vectors left hold the Hilbert basis. It suits wide systems where the kernel is large. As with
+// Pottier, Limits only filter the bases found and when ctx is done no bases are returned.
+type ProjectAndLift struct{}
+
+//Hilbert computes the Hilbert basis of A𝑥 = 0, see Algorithm
+func (ProjectAndLift) Hilbert(ctx context.Context, A *mat.Mat, s *Solver) ([]*vec.Vec, error) {
+	_, cols := A.Shape()
+	kernel := A.Kernel()
+	if len(kernel) == 0 {
+		//only zero solves the system
+		return make([]*vec.Vec, 0), nil
+	}
+
+	//in echelon form the pivots are coordinates the kernel vectors are told apart by
+	H, _ := mat.NewMatRows(kernel...).HermiteNormalForm()
+	on := make([]bool, cols)
+	G := make([]*vec.Vec, 0, 2*len(kernel))
+	for r := uint(0); r < uint(len(kernel)); r++ {
+		h := H.GetRow(r).Slice(0, cols)
+		for c := uint(0); c < cols; c++ {
+			if h.Get(c).Sign() != 0 {
+				on[c] = true
+				break
+			}
+		}
+		G = append(G, h, h.Scalar(internal.NegOne))
+	}
+
+	//project, the pivots are all fixed at once and only then made non-negative
+	G, err := complete(ctx, G, on)
+	if err != nil {
+		return make([]*vec.Vec, 0), err
+	}
+	for c := uint(0); c < cols; c++ {
+		if on[c] {
+			G = nonNegative(G, c)
+		}
+	}
+
+	//lift the rest one coordinate at a time
+	for c := uint(0); c < cols; c++ {
+		if on[c] {
+			continue
+		}
+		on[c] = true
+		if G, err = complete(ctx, G, on); err != nil {
+			return make([]*vec.Vec, 0), err
+		}
+		G = nonNegative(G, c)
+	}
+	return minimal(G, func(d *vec.Vec) bool { return natural(d) }), nil
+}
+
+//nonNegative keeps the vectors in G with a non-negative value at c
+func nonNegative(G []*vec.Vec, c uint) []*vec.Vec {
+	kept := make([]*vec.Vec, 0, len(G))
+	for _, g := range G {
+		if g.Get(c).Sign() >= 0 {
+			kept = append(kept, g)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,22 @@
+package lde
+
+import (
+	"context"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"testing"
+)
+
+func TestProjectAndLift(t *testing.T) {
+	sameAsContejeanDevie(t, ProjectAndLift{})
+}
+
+func TestProjectAndLift_OnlyZero(t *testing.T) {
+	bases, err := (&Solver{Algorithm: ProjectAndLift{}}).Homogeneous(context.Background(), mat.NewMatRows(vec.NewVecInt64(1, 2), vec.NewVecInt64(3, 1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bases) != 0 {
+		t.Errorf("expected no bases but found %v", bases)
+	}
+}
@@ -0,0 +1,241 @@
+package lde
+
+import (
+	"context"
+	"github.com/nathanhack/lde/internal"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"sync"
+)
+
+//Solver holds the settings used when solving a system. The zero value solves serially
+// with no limits, exactly like Homogeneous and NonHomogeneous. Its methods return ErrEmptySystem, a
+// *LengthError or an *InputError for malformed input, where the package level functions without an
+// error result panic with that same error.
+type Solver struct {
+	//Limits prune the search, any vector a limit stops is not explored further. They see the variables
+	// of the system being solved, for A𝑥 = b that is 𝑥 without the 𝑥0 standing for -b.
+	Limits []LimitBy
+
+	//Workers is the number of goroutines used to process each level of the search. Values
+	// below 2 process serially. When more than one worker is used every LimitBy must be safe
+	// for concurrent use. The results are the same for any number of workers.
+	Workers int
+
+	//Observer, when set, is told about each level of the search as it finishes
+	Observer Observer
+
+	//Order sorts the returned bases and solutions, nil means Lex
+	Order Order
+
+	//Checkpoint, when set, is handed a Snapshot at the start of every level of Homogeneous and
+	// NonHomogeneous searches, ready to be written out and later passed to Resume. Returning an error
+	// stops the search with an *IncompleteError wrapping it. Only the built in limits can be saved,
+	// any other limit makes the solve fail up front with an *InputError. Checkpoints need the
+	// ContejeanDevie algorithm.
+	Checkpoint func(snapshot *Snapshot) error
+
+	//Algorithm computes the Hilbert bases the solutions are made from, nil means ContejeanDevie or,
+	// when A has a single row and Workers is below 2, ClausenFortenbacher
+	Algorithm Algorithm
+
+	//extended keeps the limits of NonHomogeneous seeing [𝑥0|𝑥] as the package level functions always
+	// have
+	extended bool
+}
+
+//Homogeneous solves A𝑥 = 0 and returns the minimal bases sorted by Order. If ctx is done before the
+// search completes the bases found so far are returned with an *IncompleteError. A system without
+// variables returns ErrEmptySystem.
+func (s *Solver) Homogeneous(ctx context.Context, A *mat.Mat) ([]*vec.Vec, error) {
+	if err := validate(A); err != nil {
+		return make([]*vec.Vec, 0), err
+	}
+	if !s.contejeanDevie() {
+		𝓑, err := s.hilbert(ctx, A)
+		return s.order(𝓑), err
+	}
+	if s.singleEquation(A) {
+		𝓑, err := ClausenFortenbacher{}.Hilbert(ctx, A, s)
+		return s.order(𝓑), err
+	}
+	snapshot, err := s.snapshot(A, nil)
+	if err != nil {
+		return make([]*vec.Vec, 0), err
+	}
+	𝓟, eigens := homogeneousStart(A)
+	𝓑, err := s.homogeneous(ctx, A, frontier{level: 1, 𝓟: 𝓟}, eigens, snapshot, nil)
+	return s.order(𝓑), err
+}
+
+//HomogeneousFunc solves A𝑥 = 0 and hands each minimal basis to fn as soon as it is found.
+// Returning false from fn stops the search early. A system without variables returns ErrEmptySystem.
+func (s *Solver) HomogeneousFunc(ctx context.Context, A *mat.Mat, fn func(basis *vec.Vec) bool) error {
+	if err := validate(A); err != nil {
+		return err
+	}
+	if !s.contejeanDevie() {
+		𝓑, err := s.hilbert(ctx, A)
+		for _, v := range 𝓑 {
+			if !fn(v) {
+				break
+			}
+		}
+		return err
+	}
+	if s.singleEquation(A) {
+		_, eigens := homogeneousStart(A)
+		_, err := s.clausenFortenbacher(ctx, A.GetRow(0), eigens, 0, fn)
+		return err
+	}
+	snapshot, err := s.snapshot(A, nil)
+	if err != nil {
+		return err
+	}
+	𝓟, eigens := homogeneousStart(A)
+	_, err = s.homogeneous(ctx, A, frontier{level: 1, 𝓟: 𝓟}, eigens, snapshot, fn)
+	return err
+}
+
+//NonHomogeneous solves A𝑥 = b and returns the specific solutions (M1) and homogeneous bases (M0)
+// sorted by Order. If ctx is done before the search completes the solutions found so far are
+// returned with an *IncompleteError. When Feasible rules out any solution the search is
+// skipped and its *InfeasibleError is returned. Malformed input returns ErrEmptySystem or a
+// *LengthError before any work is done.
+func (s *Solver) NonHomogeneous(ctx context.Context, A *mat.Mat, b *vec.Vec) (M1 []*vec.Vec, M0 []*vec.Vec, err error) {
+	if err := validateB(A, b); err != nil {
+		return make([]*vec.Vec, 0), make([]*vec.Vec, 0), err
+	}
+	if err := Feasible(A, b); err != nil {
+		return make([]*vec.Vec, 0), make([]*vec.Vec, 0), err
+	}
+	_, cols := A.Shape()
+	if !s.contejeanDevie() {
+		M1, M0, err = s.variables(cols).nonHomogeneousHilbert(ctx, A, b)
+		return s.order(M1), s.order(M0), err
+	}
+	if s.singleEquation(A) {
+		a, starts := clausenFortenbacherStart(A.GetRow(0), b)
+		𝓑, err := s.variables(cols).clausenFortenbacher(ctx, a, starts, 1, nil)
+		M1, M0 = split(𝓑)
+		return s.order(M1), s.order(M0), err
+	}
+	snapshot, err := s.snapshot(A, b)
+	if err != nil {
+		return make([]*vec.Vec, 0), make([]*vec.Vec, 0), err
+	}
+	newA, 𝓟, eigens := nonHomogeneousStart(A, b)
+	𝓑, err := s.variables(cols).homogeneous(ctx, newA, frontier{level: 1, 𝓟: 𝓟}, eigens, snapshot, nil)
+	M1, M0 = split(𝓑)
+	return s.order(M1), s.order(M0), err
+}
+
+//NonHomogeneousFunc solves A𝑥 = b and hands each solution to fn as soon as it is found.
+// specific is true for members of M1 and false for members of M0. Returning false from fn
+// stops the search early. When Feasible rules out any solution nothing is searched and its
+// *InfeasibleError is returned. Malformed input returns ErrEmptySystem or a *LengthError.
+func (s *Solver) NonHomogeneousFunc(ctx context.Context, A *mat.Mat, b *vec.Vec, fn func(x *vec.Vec, specific bool) bool) error {
+	if err := validateB(A, b); err != nil {
+		return err
+	}
+	if err := Feasible(A, b); err != nil {
+		return err
+	}
+	_, cols := A.Shape()
+	if !s.contejeanDevie() {
+		M1, M0, err := s.variables(cols).nonHomogeneousHilbert(ctx, A, b)
+		for _, x := range M1 {
+			if !fn(x, true) {
+				return err
+			}
+		}
+		for _, x := range M0 {
+			if !fn(x, false) {
+				return err
+			}
+		}
+		return err
+	}
+	if s.singleEquation(A) {
+		a, starts := clausenFortenbacherStart(A.GetRow(0), b)
+		_, err := s.variables(cols).clausenFortenbacher(ctx, a, starts, 1, func(v *vec.Vec) bool {
+			return fn(v.Slice(1, v.Len()), v.Get(0).Sign() != 0)
+		})
+		return err
+	}
+	snapshot, err := s.snapshot(A, b)
+	if err != nil {
+		return err
+	}
+	newA, 𝓟, eigens := nonHomogeneousStart(A, b)
+	_, err = s.variables(cols).homogeneous(ctx, newA, frontier{level: 1, 𝓟: 𝓟}, eigens, snapshot, func(v *vec.Vec) bool {
+		return fn(v.Slice(1, v.Len()), v.Get(0).Cmp(internal.Zero) != 0)
+	})
+	return err
+}
+
+//variables returns a copy of the solver for the search over [-b|A] with limits that only see the cols
+// variables of A𝑥 = b, leaving out 𝑥0
+func (s *Solver) variables(cols uint) *Solver {
+	if s.extended {
+		return s
+	}
+	v := *s
+	v.Limits = make([]LimitBy, len(s.Limits))
+	for i, l := range s.Limits {
+		v.Limits[i] = &sliceLimit{limit: l, start: 1, end: cols + 1}
+	}
+	return &v
+}
+
+//sliceLimit applies a limit to only the [start,end) part of the vectors in the search
+type sliceLimit struct {
+	limit      LimitBy
+	start, end uint
+}
+
+func (s *sliceLimit) Stop(current *vec.Vec) bool {
+	return s.limit.Stop(current.Slice(s.start, s.end))
+}
+
+//stopped is true when one of the solver's limits stops v
+func (s *Solver) stopped(v *vec.Vec) bool {
+	for _, l := range s.Limits {
+		if l.Stop(v) {
+			return true
+		}
+	}
+	return false
+}
+
+//each calls fn for every index in [0,n) spreading the work over the solver's workers.
+// fn must only write to state owned by its index.
+func (s *Solver) each(n int, fn func(i int)) {
+	workers := s.Workers
+	if workers > n {
+		workers = n
+	}
+	if workers < 2 {
+		for i := 0; i < n; i++ {
+			fn(i)
+		}
+		return
+	}
+
+	chunk := (n + workers - 1) / workers
+	wg := sync.WaitGroup{}
+	for start := 0; start < n; start += chunk {
+		end := start + chunk
+		if end > n {
+			end = n
+		}
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			for i := start; i < end; i++ {
+				fn(i)
+			}
+		}(start, end)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,103 @@
+package lde
+
+import (
+	"context"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+	"strconv"
+	"testing"
+)
+
+func TestSolver_Workers(t *testing.T) {
+	tests := []struct {
+		a *mat.Mat
+		b *vec.Vec
+	}{
+		{mat.NewMatRows(vec.NewVecInt64(-1, 1, 2, -3), vec.NewVecInt64(-1, 3, -2, -1)), vec.NewVecInt64(0, 0)},
+		{mat.NewMatRows(vec.NewVecInt64(6, -9, 2)), vec.NewVecInt64(4)},
+		{mat.NewMatRows(vec.NewVecInt64(3, 9, 5, -7, -2)), vec.NewVecInt64(20)},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			serial := &Solver{}
+			expectedM1, expectedM0, err := serial.NonHomogeneous(context.Background(), test.a, test.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, workers := range []int{2, 3, 8} {
+				parallel := &Solver{Workers: workers, Limits: []LimitBy{NewMaxXLimit(big.NewInt(100))}}
+				actualM1, actualM0, err := parallel.NonHomogeneous(context.Background(), test.a, test.b)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !equalVecs(expectedM1, actualM1) {
+					t.Errorf("workers %v: expected %v but found %v", workers, expectedM1, actualM1)
+				}
+				if !equalVecs(expectedM0, actualM0) {
+					t.Errorf("workers %v: expected %v but found %v", workers, expectedM0, actualM0)
+				}
+			}
+		})
+	}
+}
+
+func TestSolver_Limits(t *testing.T) {
+	tests := []struct {
+		a          *mat.Mat
+		b          *vec.Vec
+		limit      LimitBy
+		expectedM1 []*vec.Vec
+		expectedM0 []*vec.Vec
+	}{
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, 1)),
+			vec.NewVecInt64(1),
+			NewBoundsLimit(Bounds{0: {Hi: big.NewInt(0)}}),
+			[]*vec.Vec{vec.NewVecInt64(0, 1)},
+			[]*vec.Vec{},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, 1, 0), vec.NewVecInt64(0, 0, 1)),
+			vec.NewVecInt64(1, 1),
+			NewBoundsLimit(Bounds{0: {Hi: big.NewInt(0)}}),
+			[]*vec.Vec{vec.NewVecInt64(0, 1, 1)},
+			[]*vec.Vec{},
+		},
+		{
+			mat.NewMatRows(vec.NewVecInt64(1, 1)),
+			vec.NewVecInt64(2),
+			NewMaxNormLimit(big.NewInt(2)),
+			[]*vec.Vec{vec.NewVecInt64(0, 2), vec.NewVecInt64(1, 1), vec.NewVecInt64(2, 0)},
+			[]*vec.Vec{},
+		},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			s := &Solver{Limits: []LimitBy{test.limit}}
+			actualM1, actualM0, err := s.NonHomogeneous(context.Background(), test.a, test.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equalVecs(test.expectedM1, actualM1) {
+				t.Errorf("expected %v but found %v", test.expectedM1, actualM1)
+			}
+			if !equalVecs(test.expectedM0, actualM0) {
+				t.Errorf("expected %v but found %v", test.expectedM0, actualM0)
+			}
+		})
+	}
+}
+
+func equalVecs(expected, actual []*vec.Vec) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i, x := range expected {
+		if actual[i].Cmp(x) != 0 {
+			return false
+		}
+	}
+	return true
+}
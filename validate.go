@@ -0,0 +1,48 @@
+package lde
+
+import (
+	"errors"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+)
+
+//validate checks A up front so malformed systems are rejected before any work is done
+func validate(A *mat.Mat) error {
+	if A == nil {
+		return ErrEmptySystem
+	}
+	if _, cols := A.Shape(); cols == 0 {
+		return ErrEmptySystem
+	}
+	return nil
+}
+
+//validateB checks A like validate and that b has a value for each row of A
+func validateB(A *mat.Mat, b *vec.Vec) error {
+	if err := validate(A); err != nil {
+		return err
+	}
+	return validateLen("b", b, A)
+}
+
+//validateLen checks that v, named arg, has a value for each row of A
+func validateLen(arg string, v *vec.Vec, A *mat.Mat) error {
+	rows, _ := A.Shape()
+	if v == nil {
+		return &LengthError{Arg: arg, Expected: rows}
+	}
+	if v.Len() != rows {
+		return &LengthError{Arg: arg, Expected: rows, Found: v.Len()}
+	}
+	return nil
+}
+
+//mustBeValid panics with err when it reports malformed input, which is how the package level functions
+// without an error result reject it
+func mustBeValid(err error) {
+	var length *LengthError
+	var input *InputError
+	if errors.Is(err, ErrEmptySystem) || errors.As(err, &length) || errors.As(err, &input) {
+		panic(err)
+	}
+}
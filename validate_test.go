@@ -0,0 +1,95 @@
+package lde
+
+import (
+	"context"
+	"errors"
+	"github.com/nathanhack/lde/mat"
+	"github.com/nathanhack/lde/vec"
+	"math/big"
+	"strconv"
+	"testing"
+)
+
+func TestSolver_Validate(t *testing.T) {
+	A := mat.NewMatRows(vec.NewVecInt64(1, -2), vec.NewVecInt64(3, 1))
+	s := &Solver{}
+	ctx := context.Background()
+	tests := []struct {
+		run      func() error
+		expected interface{}
+	}{
+		{func() error { _, err := s.Homogeneous(ctx, mat.NewMat(0, 0)); return err }, ErrEmptySystem},
+		{func() error { _, _, err := s.NonHomogeneous(ctx, A, vec.NewVecInt64(1)); return err }, &LengthError{}},
+		{func() error { _, _, err := s.NonHomogeneous(ctx, A, nil); return err }, &LengthError{}},
+		{func() error { return s.NonHomogeneousFunc(ctx, A, vec.NewVecInt64(1, 2, 3), nil) }, &LengthError{}},
+		{func() error {
+			_, _, err := s.NonHomogeneousBounded(ctx, A, vec.NewVecInt64(1, 2), Bounds{0: {Lo: big.NewInt(-1)}})
+			return err
+		}, &InputError{}},
+		{func() error { _, _, err := s.Inequality(ctx, A, []Relation{Equal}, vec.NewVecInt64(1, 2)); return err }, &LengthError{}},
+		{func() error { _, _, err := s.Mixed(ctx, A, []Domain{Natural, 7}, vec.NewVecInt64(1, 2)); return err }, &InputError{}},
+		{func() error { _, _, err := s.Congruence(ctx, A, vec.NewVecInt64(1, 2), vec.NewVecInt64(3)); return err }, &LengthError{}},
+		{func() error { _, err := Count(A, vec.NewVecInt64(1, 2), Bounds{5: {}}); return err }, &InputError{}},
+		{func() error { _, err := Decompose(A, nil, vec.NewVecInt64(1), nil, nil); return err }, &LengthError{}},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			err := test.run()
+			switch expected := test.expected.(type) {
+			case *LengthError:
+				if !errors.As(err, &expected) {
+					t.Errorf("expected a *LengthError but found %v", err)
+				}
+			case *InputError:
+				if !errors.As(err, &expected) {
+					t.Errorf("expected an *InputError but found %v", err)
+				}
+			case error:
+				if !errors.Is(err, expected) {
+					t.Errorf("expected %v but found %v", expected, err)
+				}
+			}
+		})
+	}
+}
+
+func TestPanicsOnMalformed(t *testing.T) {
+	A := mat.NewMatRows(vec.NewVecInt64(1, -1))
+	tests := []struct {
+		run      func()
+		expected interface{}
+	}{
+		{func() { NonHomogeneous(A, vec.NewVecInt64(1, 2)) }, &LengthError{}},
+		{func() { NonHomogeneous(A, nil) }, &LengthError{}},
+		{func() { NonHomogeneousFunc(A, vec.NewVecInt64(), func(*vec.Vec, bool) bool { return true }) }, &LengthError{}},
+		{func() { Homogeneous(nil) }, ErrEmptySystem},
+		{func() { HomogeneousFunc(nil, func(*vec.Vec) bool { return true }) }, ErrEmptySystem},
+		{func() { NonHomogeneousBounded(A, vec.NewVecInt64(1, 0), nil) }, &LengthError{}},
+		{func() { Mixed(A, []Domain{Free}, vec.NewVecInt64(1)) }, &LengthError{}},
+		{func() { Inequality(A, []Relation{}, vec.NewVecInt64(1)) }, &LengthError{}},
+		{func() { Congruence(A, vec.NewVecInt64(1), vec.NewVecInt64(2, 3)) }, &LengthError{}},
+		{func() { NonHomogeneousBounded(A, vec.NewVecInt64(1), Bounds{2: {}}) }, &InputError{}},
+	}
+	for i, test := range tests {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			defer func() {
+				err, _ := recover().(error)
+				switch expected := test.expected.(type) {
+				case *LengthError:
+					if !errors.As(err, &expected) {
+						t.Errorf("expected a panic with a %T but found %v", expected, err)
+					}
+				case *InputError:
+					if !errors.As(err, &expected) {
+						t.Errorf("expected a panic with a %T but found %v", expected, err)
+					}
+				case error:
+					if !errors.Is(err, expected) {
+						t.Errorf("expected a panic with %v but found %v", expected, err)
+					}
+				}
+			}()
+			test.run()
+		})
+	}
+}